@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+const apiConcurrencyMiddlewareID = "TerraformAPIConcurrency"
+
+// withAPIConcurrency returns a copy of the AWS SDK for Go v2 configuration in which
+// each API call attempt must acquire the specified semaphore before being sent.
+func withAPIConcurrency(cfg *aws.Config, servicePackageName string, semaphore tfsync.Semaphore) *aws.Config {
+	v := *cfg
+	// Clone so that the provider-wide configuration's options are not modified.
+	v.APIOptions = append(slices.Clone(v.APIOptions), func(stack *middleware.Stack) error {
+		// Add after retries so that a call does not hold its slot while backing off.
+		return stack.Finalize.Add(&apiConcurrencyMiddleware{
+			servicePackageName: servicePackageName,
+			semaphore:          semaphore,
+		}, middleware.After)
+	})
+
+	return &v
+}
+
+type apiConcurrencyMiddleware struct {
+	servicePackageName string
+	semaphore          tfsync.Semaphore
+}
+
+func (m *apiConcurrencyMiddleware) ID() string {
+	return apiConcurrencyMiddlewareID
+}
+
+func (m *apiConcurrencyMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	select {
+	case m.semaphore <- struct{}{}:
+	default:
+		// At the limit, wait for a slot.
+		start := time.Now()
+		if err := m.semaphore.WaitContext(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+		tflog.Debug(ctx, "Waited for API concurrency limit", map[string]any{
+			"tf_aws.service_package":       m.servicePackageName,
+			"tf_aws.api_concurrency.limit": cap(m.semaphore),
+			"tf_aws.api_concurrency.wait":  time.Since(start).String(),
+		})
+	}
+	defer m.semaphore.Notify()
+
+	return next.HandleFinalize(ctx, in)
+}
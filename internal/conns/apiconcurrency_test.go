@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+func TestWithAPIConcurrency(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws.Config{
+		APIOptions: make([]func(*middleware.Stack) error, 0, 2),
+	}
+	semaphore := tfsync.NewSemaphore(1)
+
+	got := withAPIConcurrency(cfg, "route53", semaphore)
+
+	if a, e := len(cfg.APIOptions), 0; a != e {
+		t.Errorf("original APIOptions length = %d, want %d", a, e)
+	}
+	if a, e := len(got.APIOptions), 1; a != e {
+		t.Fatalf("APIOptions length = %d, want %d", a, e)
+	}
+
+	stack := middleware.NewStack("test", nil)
+	if err := got.APIOptions[0](stack); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := stack.Finalize.Get(apiConcurrencyMiddlewareID); !ok {
+		t.Errorf("middleware %q not found", apiConcurrencyMiddlewareID)
+	}
+}
+
+func TestAPIConcurrencyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore(1)
+	m := &apiConcurrencyMiddleware{
+		servicePackageName: "route53",
+		semaphore:          semaphore,
+	}
+
+	// Occupy the only slot.
+	semaphore.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	called := false
+	next := middleware.FinalizeHandlerFunc(func(context.Context, middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		called = true
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+	})
+
+	if _, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if called {
+		t.Fatal("next handler called while at concurrency limit")
+	}
+
+	semaphore.Notify()
+
+	if _, _, err := m.HandleFinalize(context.Background(), middleware.FinalizeInput{}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !called {
+		t.Fatal("next handler not called")
+	}
+	if a, e := len(semaphore), 0; a != e {
+		t.Errorf("semaphore length = %d, want %d", a, e)
+	}
+}
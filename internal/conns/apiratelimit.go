@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// ServiceRateLimit is the client-side rate limiting configuration for a single service.
+type ServiceRateLimit struct {
+	// FailFast returns an error instead of waiting when the service's request rate is limited.
+	FailFast bool
+}
+
+// newAdaptiveRateLimit returns an adaptive attempt rate limiter to be shared by all API clients for a service.
+func newAdaptiveRateLimit(v ServiceRateLimit) *retry.AdaptiveMode {
+	return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+		o.FailOnNoAttemptTokens = v.FailFast
+	})
+}
+
+// withAdaptiveRateLimit returns a copy of the AWS SDK for Go v2 configuration in which
+// each API call attempt is rate limited by the specified adaptive rate limiter.
+// The limiter's sending rate is reduced when attempts are throttled and restored when they are not.
+// The configuration is returned unchanged if its Retryer already rate limits attempts adaptively.
+func withAdaptiveRateLimit(cfg *aws.Config, rateLimit *retry.AdaptiveMode) *aws.Config {
+	if cfg.RetryMode == aws.RetryModeAdaptive {
+		return cfg
+	}
+
+	v := *cfg
+	retryer := cfg.Retryer
+	v.Retryer = func() aws.Retryer {
+		var r aws.Retryer
+		if retryer != nil {
+			r = retryer()
+		}
+		if r == nil {
+			r = retry.NewStandard()
+		}
+
+		return &adaptiveRateLimitRetryer{
+			RetryerV2: wrapAsRetryerV2(r),
+			rateLimit: rateLimit,
+		}
+	}
+
+	return &v
+}
+
+// wrapAsRetryerV2 returns the Retryer as a RetryerV2.
+// A Retryer which does not implement RetryerV2 gets its attempt tokens from GetInitialToken,
+// as the AWS SDK for Go v2 does.
+func wrapAsRetryerV2(r aws.Retryer) aws.RetryerV2 {
+	if v, ok := r.(aws.RetryerV2); ok {
+		return v
+	}
+
+	return wrappedAsRetryerV2{Retryer: r}
+}
+
+type wrappedAsRetryerV2 struct {
+	aws.Retryer
+}
+
+func (w wrappedAsRetryerV2) GetAttemptToken(context.Context) (func(error) error, error) {
+	return w.Retryer.GetInitialToken(), nil
+}
+
+// adaptiveRateLimitRetryer adds a shared adaptive attempt rate limit to a Retryer.
+// All other retry behavior, e.g. maximum attempts and backoff, is that of the wrapped Retryer.
+type adaptiveRateLimitRetryer struct {
+	aws.RetryerV2
+	rateLimit *retry.AdaptiveMode
+}
+
+func (r *adaptiveRateLimitRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	releaseRateLimit, err := r.rateLimit.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		// Updates the sending rate based on whether or not the attempt was throttled.
+		if err := releaseRateLimit(err); err != nil {
+			return err
+		}
+		return release(err)
+	}, nil
+}
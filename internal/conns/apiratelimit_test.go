@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+func TestWithAdaptiveRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cfg := &aws.Config{
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = 25
+			})
+		},
+	}
+	rateLimit := newAdaptiveRateLimit(ServiceRateLimit{})
+
+	got := withAdaptiveRateLimit(cfg, rateLimit)
+
+	if _, ok := cfg.Retryer().(*adaptiveRateLimitRetryer); ok {
+		t.Fatal("original Retryer was modified")
+	}
+
+	retryer, ok := got.Retryer().(*adaptiveRateLimitRetryer)
+	if !ok {
+		t.Fatalf("Retryer = %T, want %T", got.Retryer(), retryer)
+	}
+	if retryer.rateLimit != rateLimit {
+		t.Error("Retryer does not use the shared rate limiter")
+	}
+	if a, e := retryer.MaxAttempts(), 25; a != e {
+		t.Errorf("MaxAttempts = %d, want %d", a, e)
+	}
+
+	release, err := retryer.GetAttemptToken(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := release(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestWithAdaptiveRateLimit_retryerV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cfg := &aws.Config{
+		Retryer: func() aws.Retryer {
+			return retryerV1{Retryer: retry.NewStandard()}
+		},
+	}
+
+	got := withAdaptiveRateLimit(cfg, newAdaptiveRateLimit(ServiceRateLimit{}))
+
+	retryer, ok := got.Retryer().(*adaptiveRateLimitRetryer)
+	if !ok {
+		t.Fatalf("Retryer = %T, want %T", got.Retryer(), retryer)
+	}
+
+	release, err := retryer.GetAttemptToken(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := release(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestWithAdaptiveRateLimit_adaptiveRetryMode(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws.Config{
+		RetryMode: aws.RetryModeAdaptive,
+		Retryer: func() aws.Retryer {
+			return retry.NewAdaptiveMode()
+		},
+	}
+
+	got := withAdaptiveRateLimit(cfg, newAdaptiveRateLimit(ServiceRateLimit{}))
+
+	if _, ok := got.Retryer().(*adaptiveRateLimitRetryer); ok {
+		t.Error("Retryer is rate limited twice")
+	}
+}
+
+// retryerV1 hides any RetryerV2 implementation of the wrapped Retryer.
+type retryerV1 struct {
+	aws.Retryer
+}
@@ -15,21 +15,33 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// defaultAssumeRoleSessionName is the session name used when assuming a per-resource IAM Role override
+// if the provider assumes no IAM Role with a session name.
+const defaultAssumeRoleSessionName = "terraform-provider-aws"
+
 type AWSClient struct {
 	accountID                 string
+	apiConcurrency            map[string]tfsync.Semaphore        // Service package name -> semaphore.
+	assumeRoleCredentials     map[string]aws.CredentialsProvider // IAM Role ARN -> credentials provider.
+	assumeRoleSessionName     string                             // From provider configuration.
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[clientCacheKey]map[string]any // (Region, IAM Role ARN) -> service package name -> API client.
+	credentialsLock           sync.Mutex
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -37,14 +49,32 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	partitionConfigs          map[string]*partitionConfig    // Partition ID -> configuration, from provider configuration.
+	rateLimits                map[string]*retry.AdaptiveMode // Service package name -> adaptive rate limiter.
 	servicePackages           map[string]ServicePackage
-	s3ExpressClient           *s3.Client
+	s3ExpressClients          map[clientCacheKey]*s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagPolicyConfig           *tftags.PolicyConfig
 	terraformVersion          string // From provider configuration.
 }
 
+// clientCacheKey identifies the cached API clients for a Region and any per-resource IAM Role override.
+type clientCacheKey struct {
+	region        string
+	assumeRoleARN string
+}
+
+// partitionConfig represents the configuration used to manage resources in an AWS partition
+// other than the provider's configured partition.
+type partitionConfig struct {
+	accountID   string
+	credentials aws.CredentialsProvider
+	partition   endpoints.Partition
+	region      string // Region used to configure the credentials.
+}
+
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
 	c.servicePackages = maps.Clone(servicePackages)
 }
@@ -66,14 +96,28 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
+// If the currently in-process operation has defined a per-resource IAM Role override,
+// the returned provider supplies credentials for that role.
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
 	if c.awsConfig == nil {
 		return nil
 	}
+	if roleARN := c.AssumeRoleARN(ctx); roleARN != "" {
+		return c.assumeRoleCredentialsProvider(roleARN)
+	}
+	if pc, ok := c.inContextPartitionConfig(ctx); ok {
+		return pc.credentials
+	}
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the tags to default on resources.
+// Within a resource's handlers only the tags that apply to the resource's type are returned.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -81,18 +125,83 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// The returned configuration's credentials are those for the currently in-process operation,
+// see CredentialsProvider.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Credentials = c.CredentialsProvider(ctx)
+	return cfg
+}
+
+// AccountID returns the ID of the effective AWS account.
+// If the currently in-process operation has defined a per-resource IAM Role override,
+// the IAM Role's account ID is returned.
+// If the per-resource Region override is in a partition configured in a `partition_credentials` block,
+// that partition's account ID is returned, otherwise the configured account ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	return c.AccountIDForAssumeRoleARN(ctx, c.AssumeRoleARN(ctx))
+}
+
+// AccountIDForAssumeRoleARN returns the ID of the AWS account used with the specified per-resource IAM Role override.
+// If roleARN is empty, the account ID for any per-resource Region override is returned, see AccountID.
+func (c *AWSClient) AccountIDForAssumeRoleARN(ctx context.Context, roleARN string) string {
+	if roleARN != "" {
+		if v, err := arn.Parse(roleARN); err == nil {
+			return v.AccountID
+		}
+	}
+	if pc, ok := c.inContextPartitionConfig(ctx); ok {
+		return pc.accountID
+	}
+
 	return c.accountID
 }
 
-// Partition returns the ID of the configured AWS partition.
-func (c *AWSClient) Partition(context.Context) string {
-	return c.partition.ID()
+// AssumeRoleARN returns the ARN of any per-resource IAM Role override defined by the currently in-process operation.
+func (c *AWSClient) AssumeRoleARN(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRoleARN()
+	}
+
+	return ""
+}
+
+// Partition returns the ID of the effective AWS partition.
+// If the per-resource Region override is in a partition configured in a `partition_credentials` block,
+// that partition's ID is returned, otherwise the configured partition's ID is returned.
+func (c *AWSClient) Partition(ctx context.Context) string {
+	return c.effectivePartition(ctx).ID()
+}
+
+// effectivePartition returns the effective AWS partition.
+func (c *AWSClient) effectivePartition(ctx context.Context) endpoints.Partition {
+	if pc, ok := c.inContextPartitionConfig(ctx); ok {
+		return pc.partition
+	}
+
+	return c.partition
+}
+
+// inContextPartitionConfig returns the configuration for the partition of any per-resource Region override
+// if that partition is configured in a `partition_credentials` block.
+func (c *AWSClient) inContextPartitionConfig(ctx context.Context) (*partitionConfig, bool) {
+	if len(c.partitionConfigs) == 0 {
+		return nil, false
+	}
+
+	if inContext, ok := FromContext(ctx); ok {
+		if r := inContext.OverrideRegion(); r != "" {
+			pc, ok := c.partitionConfigs[names.PartitionForRegion(r).ID()]
+			return pc, ok
+		}
+	}
+
+	return nil, false
 }
 
 // Region returns the ID of the effective AWS Region.
@@ -172,18 +281,22 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	key := c.clientCacheKey(ctx)
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[clientCacheKey]*s3.Client)
+	}
+	if _, ok := c.s3ExpressClients[key]; !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			c.s3ExpressClients[key] = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			c.s3ExpressClients[key] = s3Client
 		}
 	}
 
-	return c.s3ExpressClient
+	return c.s3ExpressClients[key]
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -263,9 +376,9 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
-// DNSSuffix returns the domain suffix for the configured AWS partition.
-func (c *AWSClient) DNSSuffix(context.Context) string {
-	dnsSuffix := c.partition.DNSSuffix()
+// DNSSuffix returns the domain suffix for the effective AWS partition.
+func (c *AWSClient) DNSSuffix(ctx context.Context) string {
+	dnsSuffix := c.effectivePartition(ctx).DNSSuffix()
 	if dnsSuffix == "" {
 		dnsSuffix = "amazonaws.com"
 	}
@@ -308,12 +421,16 @@ func (c *AWSClient) EC2PublicDNSNameForIP(ctx context.Context, ip string) string
 	return c.PartitionHostname(ctx, fmt.Sprintf("ec2-%s.%s", convertIPToDashIP(ip), c.EC2RegionalPublicDNSSuffix(ctx)))
 }
 
-// ValidateInContextRegionInPartition verifies that the value of the top-level `region` attribute is in the configured AWS partition.
+// ValidateInContextRegionInPartition verifies that the value of the top-level `region` attribute is in the configured AWS partition,
+// or in a partition configured in a `partition_credentials` block.
 func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	if inContext, ok := FromContext(ctx); ok {
-		if r, p := inContext.OverrideRegion(), c.Partition(ctx); r != "" && p != "" {
+		if r, p := inContext.OverrideRegion(), c.partition.ID(); r != "" && p != "" {
 			if got, want := names.PartitionForRegion(r).ID(), p; got != want {
-				return fmt.Errorf("partition (%s) for per-resource Region (%s) is not the provider's configured partition (%s)", got, r, want)
+				if _, ok := c.partitionConfigs[got]; ok {
+					return nil
+				}
+				return fmt.Errorf("partition (%s) for per-resource Region (%s) is not the provider's configured partition (%s) and no partition_credentials block is configured for partition (%s)", got, r, want, got)
 			}
 		}
 	}
@@ -321,18 +438,108 @@ func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	return nil
 }
 
+// ValidateInContextAssumeRoleARNInPartition verifies that the value of the top-level `assume_role_arn` attribute is in the configured AWS partition.
+func (c *AWSClient) ValidateInContextAssumeRoleARNInPartition(ctx context.Context) error {
+	if roleARN, p := c.AssumeRoleARN(ctx), c.Partition(ctx); roleARN != "" && p != "" {
+		v, err := arn.Parse(roleARN)
+		if err != nil {
+			return fmt.Errorf("per-resource IAM Role (%s): %w", roleARN, err)
+		}
+		if got, want := v.Partition, p; got != want {
+			return fmt.Errorf("partition (%s) for per-resource IAM Role (%s) is not the provider's configured partition (%s)", got, roleARN, want)
+		}
+	}
+
+	return nil
+}
+
+// assumeRoleCredentialsProvider returns a credentials provider for the specified IAM Role.
+// The role is assumed using the provider's configured credentials, or if the role is in a partition
+// configured in a `partition_credentials` block, that partition's credentials.
+// The assumed role credentials are cached.
+func (c *AWSClient) assumeRoleCredentialsProvider(roleARN string) aws.CredentialsProvider {
+	c.credentialsLock.Lock()
+	defer c.credentialsLock.Unlock()
+
+	if v, ok := c.assumeRoleCredentials[roleARN]; ok {
+		return v
+	}
+
+	var pc *partitionConfig
+	if v, err := arn.Parse(roleARN); err == nil {
+		pc = c.partitionConfigs[v.Partition]
+	}
+	stsClient := sts.NewFromConfig(*c.awsConfig, func(o *sts.Options) {
+		if pc != nil {
+			o.Credentials = pc.credentials
+			o.Region = pc.region
+			return
+		}
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if endpoint := c.endpoints[names.STS]; endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+	credentialsProvider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = c.roleSessionName()
+	}))
+
+	if c.assumeRoleCredentials == nil {
+		c.assumeRoleCredentials = make(map[string]aws.CredentialsProvider)
+	}
+	c.assumeRoleCredentials[roleARN] = credentialsProvider
+
+	return credentialsProvider
+}
+
+// roleSessionName returns the session name used when assuming a per-resource IAM Role override.
+// This is the session name of the last IAM Role assumed by the provider, if any.
+func (c *AWSClient) roleSessionName() string {
+	if c.assumeRoleSessionName != "" {
+		return c.assumeRoleSessionName
+	}
+
+	return defaultAssumeRoleSessionName
+}
+
+// clientCacheKey returns the key for the cached API clients of the currently in-process operation.
+func (c *AWSClient) clientCacheKey(ctx context.Context) clientCacheKey {
+	return clientCacheKey{
+		region:        c.Region(ctx),
+		assumeRoleARN: c.AssumeRoleARN(ctx),
+	}
+}
+
 func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig, endpoint := c.awsConfig, c.endpoints[servicePackageName]
+	_, isOtherPartition := c.inContextPartitionConfig(ctx)
+	if isOtherPartition || c.AssumeRoleARN(ctx) != "" {
+		cfg := c.AwsConfig(ctx)
+		awsConfig = &cfg
+	}
+	if isOtherPartition {
+		// Custom service endpoints apply only to the configured partition.
+		endpoint = ""
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
-		"endpoint":         c.endpoints[servicePackageName],
+		"aws_sdkv2_config": awsConfig,
+		"endpoint":         endpoint,
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if semaphore, ok := c.apiConcurrency[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = withAPIConcurrency(m["aws_sdkv2_config"].(*aws.Config), servicePackageName, semaphore)
+	}
+	if rateLimit, ok := c.rateLimits[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = withAdaptiveRateLimit(m["aws_sdkv2_config"].(*aws.Config), rateLimit)
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -354,7 +561,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
-	region := c.Region(ctx)
+	key := c.clientCacheKey(ctx)
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -362,7 +569,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[key]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -399,10 +606,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[key]; !ok {
+			c.clients[key] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[key][servicePackageName] = client
 	}
 
 	return client, nil
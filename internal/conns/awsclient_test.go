@@ -231,6 +231,28 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 			Region:   endpoints.CnNorth1RegionID,
 			Expected: true,
 		},
+		{
+			Name: "AWS Commercial, partition credentials",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				partitionConfigs: map[string]*partitionConfig{
+					endpoints.AwsCnPartitionID: {partition: chinaPartition},
+				},
+			},
+			Region:   endpoints.CnNorth1RegionID,
+			Expected: true,
+		},
+		{
+			Name: "AWS Commercial, other partition credentials",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				partitionConfigs: map[string]*partitionConfig{
+					endpoints.AwsCnPartitionID: {partition: chinaPartition},
+				},
+			},
+			Region:   endpoints.UsGovWest1RegionID,
+			Expected: false,
+		},
 		{
 			Name:      "Empty partition, valid",
 			AWSClient: &AWSClient{},
@@ -243,7 +265,7 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx = NewResourceContext(ctx, "test", "Test", testCase.Region)
+			ctx = NewResourceContext(ctx, "test", "Test", testCase.Region, "")
 			err := testCase.AWSClient.ValidateInContextRegionInPartition(ctx)
 
 			if got := err == nil; got != testCase.Expected {
@@ -252,3 +274,188 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 		})
 	}
 }
+
+func TestAWSClientValidateInContextAssumeRoleARNInPartition(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		AWSClient     *AWSClient
+		AssumeRoleARN string
+		Expected      bool
+	}{
+		{
+			Name: "AWS Commercial, valid",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			AssumeRoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      true,
+		},
+		{
+			Name: "AWS Commercial, invalid",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			AssumeRoleARN: "arn:aws-cn:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      false,
+		},
+		{
+			Name: "AWS Commercial, not set",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			Expected: true,
+		},
+		{
+			Name:          "Empty partition, valid",
+			AWSClient:     &AWSClient{},
+			AssumeRoleARN: "arn:aws-cn:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", "", testCase.AssumeRoleARN)
+			err := testCase.AWSClient.ValidateInContextAssumeRoleARNInPartition(ctx)
+
+			if got := err == nil; got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientAccountID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		AssumeRoleARN string
+		Expected      string
+	}{
+		{
+			Name:     "no override",
+			Expected: "111111111111",
+		},
+		{
+			Name:          "override",
+			AssumeRoleARN: "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+			Expected:      "222222222222",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				accountID: "111111111111",
+			}
+			ctx := NewResourceContext(t.Context(), "test", "Test", "", testCase.AssumeRoleARN)
+
+			if got, want := client.AccountID(ctx), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientPartitionCredentials(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		accountID: "111111111111",
+		partition: standardPartition,
+		partitionConfigs: map[string]*partitionConfig{
+			endpoints.AwsCnPartitionID: {
+				accountID: "222222222222",
+				partition: chinaPartition,
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name              string
+		Region            string
+		ExpectedAccountID string
+		ExpectedDNSSuffix string
+		ExpectedPartition string
+	}{
+		{
+			Name:              "no override",
+			ExpectedAccountID: "111111111111",
+			ExpectedDNSSuffix: "amazonaws.com",
+			ExpectedPartition: endpoints.AwsPartitionID,
+		},
+		{
+			Name:              "configured partition",
+			Region:            endpoints.UsWest2RegionID,
+			ExpectedAccountID: "111111111111",
+			ExpectedDNSSuffix: "amazonaws.com",
+			ExpectedPartition: endpoints.AwsPartitionID,
+		},
+		{
+			Name:              "partition credentials",
+			Region:            endpoints.CnNorthwest1RegionID,
+			ExpectedAccountID: "222222222222",
+			ExpectedDNSSuffix: "amazonaws.com.cn",
+			ExpectedPartition: endpoints.AwsCnPartitionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", testCase.Region, "")
+
+			if got, want := client.AccountID(ctx), testCase.ExpectedAccountID; got != want {
+				t.Errorf("AccountID: got %s, expected %s", got, want)
+			}
+			if got, want := client.DNSSuffix(ctx), testCase.ExpectedDNSSuffix; got != want {
+				t.Errorf("DNSSuffix: got %s, expected %s", got, want)
+			}
+			if got, want := client.Partition(ctx), testCase.ExpectedPartition; got != want {
+				t.Errorf("Partition: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientRoleSessionName(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		AssumeRoleSessionName string
+		Expected              string
+	}{
+		{
+			Name:     "default",
+			Expected: "terraform-provider-aws",
+		},
+		{
+			Name:                  "provider assume_role",
+			AssumeRoleSessionName: "test-session",
+			Expected:              "test-session",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				assumeRoleSessionName: testCase.AssumeRoleSessionName,
+			}
+
+			if got, want := client.roleSessionName(), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
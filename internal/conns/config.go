@@ -6,20 +6,25 @@ package conns
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
@@ -28,6 +33,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIConcurrency                 map[string]int // Service package name -> maximum concurrent API calls.
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -43,7 +49,9 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PartitionCredentials           map[string]PartitionCredentials // Partition ID -> credentials for per-resource Region overrides in that partition.
 	Profile                        string
+	RateLimits                     map[string]ServiceRateLimit // Service package name -> client-side rate limiting.
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
@@ -56,6 +64,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -63,6 +72,16 @@ type Config struct {
 	UseFIPSEndpoint                bool
 }
 
+// PartitionCredentials represents the credentials used to manage resources in an AWS partition
+// other than the provider's configured partition.
+type PartitionCredentials struct {
+	AccessKey string
+	Profile   string
+	Region    string // Region used to configure the credentials. Defaults to a Region in the partition.
+	SecretKey string
+	Token     string
+}
+
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -144,6 +163,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		if awsbase.IsCannotAssumeRoleError(d) && len(c.AssumeRole) > 1 {
+			diags = append(diags, assumeRoleChainDiagnostic(d, c.AssumeRole, failedAssumeRoleHop(ctx, awsbaseConfig, c.AssumeRole)))
+			continue
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSDKSeverity(d.Severity()),
 			Summary:  d.Summary(),
@@ -192,22 +216,161 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+
+	if len(c.PartitionCredentials) > 0 {
+		partitionConfigs, dg := c.configurePartitionCredentials(ctx, awsbaseConfig, client.partition)
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		client.partitionConfigs = partitionConfigs
+	}
+
+	client.apiConcurrency = make(map[string]tfsync.Semaphore, len(c.APIConcurrency))
+	for servicePackageName, limit := range c.APIConcurrency {
+		client.apiConcurrency[servicePackageName] = tfsync.NewSemaphore(limit)
+	}
+	client.rateLimits = make(map[string]*retry.AdaptiveMode, len(c.RateLimits))
+	for servicePackageName, v := range c.RateLimits {
+		client.rateLimits[servicePackageName] = newAdaptiveRateLimit(v)
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
-	client.clients = make(map[string]map[string]any, 0)
+	client.clients = make(map[clientCacheKey]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	if n := len(c.AssumeRole); n > 0 {
+		client.assumeRoleSessionName = c.AssumeRole[n-1].SessionName
+	}
 
 	return client, diags
 }
 
+// configurePartitionCredentials configures the credentials for each partition in a `partition_credentials` block.
+// The partition's credentials are configured in the same way as the provider's, except that no IAM Roles are assumed.
+func (c *Config) configurePartitionCredentials(ctx context.Context, awsbaseConfig awsbase.Config, providerPartition endpoints.Partition) (map[string]*partitionConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	partitionConfigs := make(map[string]*partitionConfig, len(c.PartitionCredentials))
+
+	for partitionID, credentials := range c.PartitionCredentials {
+		if partitionID == providerPartition.ID() {
+			return nil, sdkdiag.AppendErrorf(diags, "partition_credentials: partition (%s) is the provider's configured partition", partitionID)
+		}
+
+		partition, ok := names.PartitionForID(partitionID)
+		if !ok {
+			return nil, sdkdiag.AppendErrorf(diags, "partition_credentials: unsupported partition (%s)", partitionID)
+		}
+
+		region := credentials.Region
+		if region == "" {
+			region = defaultPartitionRegion(partition)
+		}
+
+		cfg := awsbaseConfig
+		cfg.AccessKey = credentials.AccessKey
+		cfg.AllowedAccountIds = nil
+		cfg.AssumeRole = nil
+		cfg.AssumeRoleWithWebIdentity = nil
+		cfg.ForbiddenAccountIds = nil
+		cfg.Profile = credentials.Profile
+		cfg.Region = region
+		cfg.SecretKey = credentials.SecretKey
+		cfg.SsoEndpoint = ""
+		cfg.StsEndpoint = ""
+		cfg.StsRegion = ""
+		cfg.Token = credentials.Token
+
+		tflog.Debug(ctx, "Configuring partition credentials", map[string]any{
+			"partition": partitionID,
+		})
+		// As for the provider's credentials, avoid duplicate calls to STS.
+		skipCredsValidation := cfg.SkipCredsValidation
+		cfg.SkipCredsValidation = true
+		ctx, awsConfig, awsDiags := awsbase.GetAwsConfig(ctx, &cfg)
+		for _, d := range awsDiags {
+			diags = append(diags, diag.Diagnostic{
+				Severity: baseSeverityToSDKSeverity(d.Severity()),
+				Summary:  fmt.Sprintf("Configuring credentials for partition (%s): %s", partitionID, d.Summary()),
+				Detail:   d.Detail(),
+			})
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		cfg.SkipCredsValidation = skipCredsValidation
+		accountID, _, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, awsConfig, &cfg)
+		for _, d := range awsDiags {
+			diags = append(diags, diag.Diagnostic{
+				Severity: baseSeverityToSDKSeverity(d.Severity()),
+				Summary:  fmt.Sprintf("Retrieving AWS account details for partition (%s): %s", partitionID, d.Summary()),
+				Detail:   d.Detail(),
+			})
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		partitionConfigs[partitionID] = &partitionConfig{
+			accountID:   accountID,
+			credentials: awsConfig.Credentials,
+			partition:   partition,
+			region:      region,
+		}
+	}
+
+	return partitionConfigs, diags
+}
+
+// defaultPartitionRegion returns the Region used to configure a partition's credentials if none is specified.
+func defaultPartitionRegion(partition endpoints.Partition) string {
+	return slices.Min(slices.Collect(maps.Keys(partition.Regions())))
+}
+
+// failedAssumeRoleHop returns the index of the IAM Role that cannot be assumed in a chain of IAM Roles that cannot be assumed.
+// The IAM Roles are assumed hop by hop, using ever longer prefixes of the chain, until a hop fails.
+func failedAssumeRoleHop(ctx context.Context, awsbaseConfig awsbase.Config, assumeRoles []awsbase.AssumeRole) int {
+	n := len(assumeRoles)
+
+	// If all the preceding hops succeed, the last hop is the one that failed.
+	for i := range n - 1 {
+		awsbaseConfig.AssumeRole = assumeRoles[:i+1]
+		if _, _, diags := awsbase.GetAwsConfig(ctx, &awsbaseConfig); slices.ContainsFunc(diags, awsbase.IsCannotAssumeRoleError) {
+			return i
+		}
+	}
+
+	return n - 1
+}
+
+// assumeRoleChainDiagnostic returns a diagnostic for a failure to assume the IAM Role at index hop of a chain of IAM Roles.
+func assumeRoleChainDiagnostic(d basediag.Diagnostic, assumeRoles []awsbase.AssumeRole, hop int) diag.Diagnostic {
+	n := len(assumeRoles)
+	ar := assumeRoles[hop]
+
+	result := diag.Diagnostic{
+		Severity:      baseSeverityToSDKSeverity(d.Severity()),
+		Summary:       fmt.Sprintf("%s (assume_role %d of %d)", d.Summary(), hop+1, n),
+		AttributePath: cty.GetAttrPath("assume_role").IndexInt(hop),
+	}
+	if hop == 0 {
+		result.Detail = fmt.Sprintf("Role chaining failed assuming the first IAM Role (%s) in the chain using the base credentials.\n\n%s", ar.RoleARN, d.Detail())
+	} else {
+		result.Detail = fmt.Sprintf("Role chaining failed assuming IAM Role %d of %d (%s) using the session from IAM Role %d (%s).\n\n%s", hop+1, n, ar.RoleARN, hop, assumeRoles[hop-1].RoleARN, d.Detail())
+	}
+
+	return result
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -13,6 +13,7 @@ import (
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -500,3 +501,85 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAssumeRoleChainDiagnostics(t *testing.T) { //nolint:paralleltest
+	const (
+		securityRoleARN = "arn:aws:iam::222222222222:role/security"
+		workloadRoleARN = "arn:aws:iam::333333333333:role/workload"
+	)
+
+	cases := map[string]struct {
+		assumeRoles     []any
+		expectedSummary string
+		expectedPath    cty.Path
+	}{
+		"first hop": {
+			assumeRoles: []any{
+				map[string]any{"role_arn": securityRoleARN, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+				map[string]any{"role_arn": workloadRoleARN, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+			},
+			expectedSummary: "Cannot assume IAM Role (assume_role 1 of 2)",
+			expectedPath:    cty.GetAttrPath("assume_role").IndexInt(0),
+		},
+		"last hop": {
+			assumeRoles: []any{
+				map[string]any{"role_arn": servicemocks.MockStsAssumeRoleArn, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+				map[string]any{"role_arn": workloadRoleARN, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+			},
+			expectedSummary: "Cannot assume IAM Role (assume_role 2 of 2)",
+			expectedPath:    cty.GetAttrPath("assume_role").IndexInt(1),
+		},
+		"middle hop": {
+			assumeRoles: []any{
+				map[string]any{"role_arn": servicemocks.MockStsAssumeRoleArn, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+				map[string]any{"role_arn": workloadRoleARN, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+				map[string]any{"role_arn": servicemocks.MockStsAssumeRoleArn, "session_name": servicemocks.MockStsAssumeRoleSessionName},
+			},
+			expectedSummary: "Cannot assume IAM Role (assume_role 2 of 3)",
+			expectedPath:    cty.GetAttrPath("assume_role").IndexInt(1),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			})
+			defer ts.Close()
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2",
+				"max_retries":                 1,
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"assume_role":                 tc.assumeRoles,
+				"endpoints": []any{
+					map[string]any{"sts": ts.URL},
+				},
+			}
+
+			p, err := sdkv2.NewProvider(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p.TerraformVersion = "1.0.0"
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("expected %d diagnostics, got %d: %v", want, got, diags)
+			}
+			if got, want := diags[0].Summary, tc.expectedSummary; got != want {
+				t.Errorf("expected summary %q, got %q", want, got)
+			}
+			if got, want := diags[0].AttributePath, tc.expectedPath; !got.Equals(want) {
+				t.Errorf("expected attribute path %#v, got %#v", want, got)
+			}
+		})
+	}
+}
@@ -27,6 +27,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithListResources is an interface that extends ServicePackage with list resources.
+// List resources enumerate the existing resources of a managed resource type.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -37,10 +44,16 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	overrideAssumeRoleARN string // Any currently in effect per-resource IAM Role override.
+	overrideRegion        string // Any currently in effect per-resource Region override.
+	resourceName          string // Friendly resource name, e.g. "Subnet"
+	servicePackageName    string // Canonical name defined as a constant in names package
+	vcrEnabled            bool   // Whether VCR testing is enabled
+}
+
+// OverrideAssumeRoleARN returns any currently in effect per-resource IAM Role override.
+func (c *InContext) OverrideAssumeRoleARN() string {
+	return c.overrideAssumeRoleARN
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -63,12 +76,13 @@ func (c *InContext) VCREnabled() bool {
 	return c.vcrEnabled
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion, overrideAssumeRoleARN string) context.Context {
 	v := InContext{
-		overrideRegion:     overrideRegion,
-		resourceName:       resourceName,
-		servicePackageName: servicePackageName,
-		vcrEnabled:         vcr.IsEnabled(),
+		overrideAssumeRoleARN: overrideAssumeRoleARN,
+		overrideRegion:        overrideRegion,
+		resourceName:          resourceName,
+		servicePackageName:    servicePackageName,
+		vcrEnabled:            vcr.IsEnabled(),
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -4,46 +4,184 @@
 package conns
 
 import (
+	"context"
+	"log"
 	"sync"
+	"time"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
 var GlobalMutexKV = newMutexKV()
 
-// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// mutexKV is a simple key/value store for arbitrary read/write mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Entries are reference counted and removed once no caller holds or is waiting
+// for the lock on a key, so the store does not grow without bound.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*mutexKVEntry
+}
+
+// mutexKVEntry is the lock state for a single key. All fields are guarded by mutexKV.lock.
+type mutexKVEntry struct {
+	readers        int  // Number of readers holding the lock
+	writer         bool // Whether a writer holds the lock
+	writersWaiting int  // Number of writers waiting for the lock
+	refs           int  // Number of callers holding or waiting for the lock
+	// changed is closed, and replaced, whenever the lock is released.
+	changed chan struct{}
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	m.get(key).Lock()
+	_ = m.LockContext(context.Background(), key)
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is available
+// or the context is done. If the lock is acquired the caller is responsible for calling
+// Unlock for the same key, otherwise the context's error is returned.
+func (m *mutexKV) LockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, true)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	m.get(key).Unlock()
+	m.release(key, true)
+}
+
+// RLocks the mutex for the given key for reading. Multiple readers may hold the lock
+// at the same time, but not while it is held by a writer. Caller is responsible for
+// calling RUnlock for the same key
+func (m *mutexKV) RLock(key string) {
+	_ = m.RLockContext(context.Background(), key)
+}
+
+// RLockContext locks the mutex for the given key for reading, waiting until the lock is
+// available or the context is done. If the lock is acquired the caller is responsible for
+// calling RUnlock for the same key, otherwise the context's error is returned.
+func (m *mutexKV) RLockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, false)
+}
+
+// RUnlock the mutex for the given key. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	m.release(key, false)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) acquire(ctx context.Context, key string, exclusive bool) error {
+	start := time.Now()
+	waited := false
+
+	m.lock.Lock()
+	entry := m.get(key)
+	entry.refs++
+	if exclusive {
+		entry.writersWaiting++
+	}
+
+	for {
+		// Waiting writers take precedence over new readers so that writers are not starved.
+		available := !entry.writer && (exclusive && entry.readers == 0 || !exclusive && entry.writersWaiting == 0)
+		if available {
+			if exclusive {
+				entry.writersWaiting--
+				entry.writer = true
+			} else {
+				entry.readers++
+			}
+			m.lock.Unlock()
+
+			if waited {
+				log.Printf("[DEBUG] Acquired %s lock on %q after waiting %s", lockMode(exclusive), key, time.Since(start))
+			}
+
+			return nil
+		}
+
+		waited = true
+		changed := entry.changed
+		m.lock.Unlock()
+
+		select {
+		case <-changed:
+			m.lock.Lock()
+		case <-ctx.Done():
+			m.lock.Lock()
+			if exclusive {
+				entry.writersWaiting--
+				// Readers may have been held back by this writer.
+				entry.notify()
+			}
+			m.unref(key, entry)
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Gave up waiting for %s lock on %q after %s: %s", lockMode(exclusive), key, time.Since(start), ctx.Err())
+
+			return ctx.Err()
+		}
+	}
+}
+
+func (m *mutexKV) release(key string, exclusive bool) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+
+	entry, ok := m.store[key]
+	if !ok || exclusive && !entry.writer || !exclusive && entry.readers == 0 {
+		panic("unlock of unlocked mutex: " + key)
+	}
+
+	if exclusive {
+		entry.writer = false
+	} else {
+		entry.readers--
+	}
+	entry.notify()
+	m.unref(key, entry)
+}
+
+// Returns the lock state for the given key, creating it if necessary.
+// Caller must hold m.lock.
+func (m *mutexKV) get(key string) *mutexKVEntry {
+	entry, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		entry = &mutexKVEntry{
+			changed: make(chan struct{}),
+		}
+		m.store[key] = entry
+	}
+	return entry
+}
+
+// unref removes the given key's lock state once it is no longer referenced.
+// Caller must hold m.lock.
+func (m *mutexKV) unref(key string, entry *mutexKVEntry) {
+	entry.refs--
+	if entry.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+// notify wakes all callers waiting for the lock.
+// Caller must hold mutexKV.lock.
+func (e *mutexKVEntry) notify() {
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+func lockMode(exclusive bool) string {
+	if exclusive {
+		return "exclusive"
 	}
-	return mutex
+	return "shared"
 }
 
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*mutexKVEntry),
 	}
 }
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
@@ -74,3 +76,97 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVRLock(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.RLock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.RLock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second read lock blocked. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVRLockBlocksLock(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.RLock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Lock was able to be taken while read locked. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	mkv.RUnlock("foo")
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Lock blocked after read unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVLockContext(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVCleanup(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+	mkv.Unlock("foo")
+	mkv.RLock("bar")
+	mkv.RLock("bar")
+	mkv.RUnlock("bar")
+	mkv.RUnlock("bar")
+
+	mkv.lock.Lock()
+	defer mkv.lock.Unlock()
+
+	if got := len(mkv.store); got != 0 {
+		t.Fatalf("expected no entries, got %d", got)
+	}
+}
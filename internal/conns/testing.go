@@ -16,3 +16,13 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetTagPolicyConfig is only intended for use in tests
+func SetTagPolicyConfig(client *AWSClient, p *tftags.PolicyConfig) {
+	client.tagPolicyConfig = p
+}
+
+// SetAccountID is only intended for use in tests
+func SetAccountID(client *AWSClient, accountID string) {
+	client.accountID = accountID
+}
@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -23,6 +24,11 @@ var semaphoreKV = &struct {
 	store: make(map[string]Semaphore),
 }
 
+// NewSemaphore returns a semaphore that allows up to limit concurrent holders.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 // GetSemaphore returns a named semaphore with a default capacity or overrides it using an environment variable
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
@@ -51,6 +57,17 @@ func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// WaitContext waits for a semaphore before continuing, returning the context's error
+// if the context is done before the semaphore is acquired.
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Notify releases a semaphore
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
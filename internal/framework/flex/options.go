@@ -7,7 +7,8 @@ import "slices"
 
 var (
 	DefaultIgnoredFieldNames = []string{
-		"Tags", // Resource tags are handled separately.
+		"AssumeRoleARN", // Per-resource IAM Role override is handled separately.
+		"Tags",          // Resource tags are handled separately.
 	}
 )
 
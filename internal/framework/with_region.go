@@ -8,5 +8,6 @@ import (
 )
 
 type WithRegionModel struct {
-	Region types.String `tfsdk:"region"`
+	AssumeRoleARN types.String `tfsdk:"assume_role_arn"`
+	Region        types.String `tfsdk:"region"`
 }
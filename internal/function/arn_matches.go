@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = arnMatchesFunction{}
+
+func NewARNMatchesFunction() function.Function {
+	return &arnMatchesFunction{}
+}
+
+type arnMatchesFunction struct{}
+
+func (f arnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f arnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_matches Function",
+		MarkdownDescription: "Checks whether an ARN matches an ARN pattern using IAM wildcard semantics. " +
+			"A * matches any sequence of characters and a ? matches any single character, " +
+			"evaluated separately for each section of the ARN.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, optionally containing * and ? wildcards",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := arnMatches(pattern, arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// arnMatches reports whether an ARN matches a pattern.
+// As in IAM policy Resource elements, wildcards in the partition, service, region and
+// account ID sections do not match across section boundaries, and a pattern of "*"
+// matches any ARN.
+func arnMatches(pattern, s string) (bool, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return false, err
+	}
+
+	if pattern == "*" {
+		return true, nil
+	}
+
+	p, err := arn.Parse(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+
+	return wildcardMatch(p.Partition, v.Partition) &&
+		wildcardMatch(p.Service, v.Service) &&
+		wildcardMatch(p.Region, v.Region) &&
+		wildcardMatch(p.AccountID, v.AccountID) &&
+		wildcardMatch(p.Resource, v.Resource), nil
+}
+
+// wildcardMatch reports whether s matches pattern, where * matches any sequence of
+// characters (including none) and ? matches exactly one character.
+// Matching is case-sensitive.
+func wildcardMatch(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	var pi, ri int
+	// Position of the most recent * in the pattern and the input position it was tried at.
+	star, mark := -1, 0
+
+	for ri < len(r) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == r[ri]):
+			pi++
+			ri++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, ri
+			pi++
+		case star >= 0:
+			// Backtrack: let the last * consume one more character.
+			mark++
+			pi, ri = star+1, mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
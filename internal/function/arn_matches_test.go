@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:iam::*:role/app-?/*", "arn:aws:iam::444455556666:role/app-1/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testARNMatchesFunctionConfig("*", "arn:aws:s3:::example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:iam::*:role/app-?/*", "arn:aws:iam::444455556666:role/app-10/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+			{
+				// Wildcards do not match across ARN sections.
+				Config: testARNMatchesFunctionConfig("arn:aws:s3*:::example", "arn:aws:s3:us-west-2::example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:s3:::*", "invalid"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+			{
+				Config:      testARNMatchesFunctionConfig("invalid", "arn:aws:s3:::example"),
+				ExpectError: regexache.MustCompile(`invalid pattern "invalid"`),
+			},
+		},
+	})
+}
+
+func testARNMatchesFunctionConfig(pattern, arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_matches(%[1]q, %[2]q)
+}
+`, pattern, arg)
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+const (
+	iamPolicyDocumentDefaultVersion = "2012-10-17"
+	iamPolicyDocumentDefaultEffect  = "Allow"
+)
+
+var (
+	iamPolicyDocumentVersions = []string{"2008-10-17", "2012-10-17"}
+	iamPolicyDocumentEffects  = []string{"Allow", "Deny"}
+)
+
+var _ function.Function = iamPolicyDocumentFunction{}
+
+func NewIAMPolicyDocumentFunction() function.Function {
+	return &iamPolicyDocumentFunction{}
+}
+
+type iamPolicyDocumentFunction struct{}
+
+func (f iamPolicyDocumentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_document"
+}
+
+func (f iamPolicyDocumentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_document Function",
+		MarkdownDescription: "Generates a minified IAM policy document in JSON format from an object with the same structure " +
+			"as the arguments of the aws_iam_policy_document data source",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "document",
+				MarkdownDescription: "Object describing the policy document, with optional attributes version, policy_id, source_policy_documents, override_policy_documents and statement",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyDocumentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	config, err := expandIAMPolicyDocumentConfig(arg.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	doc, err := tfiam.BuildPolicyDocument(config)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	output, err := json.Marshal(doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("writing IAM Policy Document: formatting JSON: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(output)))
+}
+
+func expandIAMPolicyDocumentConfig(v attr.Value) (*tfiam.PolicyDocumentConfig, error) {
+	attrs, err := dynamicAttributes(v, "version", "policy_id", "source_policy_documents", "override_policy_documents", "statement")
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tfiam.PolicyDocumentConfig{
+		Version: iamPolicyDocumentDefaultVersion,
+	}
+
+	if v, ok := attrs["version"]; ok {
+		if config.Version, err = dynamicString(v); err != nil {
+			return nil, fmt.Errorf("version: %w", err)
+		}
+		if !slices.Contains(iamPolicyDocumentVersions, config.Version) {
+			return nil, fmt.Errorf("version: must be one of %q", iamPolicyDocumentVersions)
+		}
+	}
+	if v, ok := attrs["policy_id"]; ok {
+		if config.PolicyID, err = dynamicString(v); err != nil {
+			return nil, fmt.Errorf("policy_id: %w", err)
+		}
+	}
+	if v, ok := attrs["source_policy_documents"]; ok {
+		if config.SourcePolicyDocuments, err = dynamicStrings(v); err != nil {
+			return nil, fmt.Errorf("source_policy_documents: %w", err)
+		}
+	}
+	if v, ok := attrs["override_policy_documents"]; ok {
+		if config.OverridePolicyDocuments, err = dynamicStrings(v); err != nil {
+			return nil, fmt.Errorf("override_policy_documents: %w", err)
+		}
+	}
+
+	if v, ok := attrs["statement"]; ok {
+		elems, err := dynamicElements(v)
+		if err != nil {
+			return nil, fmt.Errorf("statement: %w", err)
+		}
+
+		for i, v := range elems {
+			stmt, err := expandIAMPolicyDocumentStatementConfig(v)
+			if err != nil {
+				return nil, fmt.Errorf("statement[%d]: %w", i, err)
+			}
+
+			config.Statements = append(config.Statements, stmt)
+		}
+	}
+
+	return config, nil
+}
+
+func expandIAMPolicyDocumentStatementConfig(v attr.Value) (tfiam.PolicyDocumentStatementConfig, error) {
+	stmt := tfiam.PolicyDocumentStatementConfig{
+		Effect: iamPolicyDocumentDefaultEffect,
+	}
+
+	attrs, err := dynamicAttributes(v, "sid", "effect", "actions", "not_actions", "resources", "not_resources", "principals", "not_principals", "condition")
+	if err != nil {
+		return stmt, err
+	}
+
+	for name, v := range attrs {
+		switch name {
+		case "sid":
+			stmt.Sid, err = dynamicString(v)
+		case "effect":
+			if stmt.Effect, err = dynamicString(v); err == nil && !slices.Contains(iamPolicyDocumentEffects, stmt.Effect) {
+				err = fmt.Errorf("must be one of %q", iamPolicyDocumentEffects)
+			}
+		case "actions":
+			stmt.Actions, err = dynamicStringSet(v)
+		case "not_actions":
+			stmt.NotActions, err = dynamicStringSet(v)
+		case "resources":
+			stmt.Resources, err = dynamicStringSet(v)
+		case "not_resources":
+			stmt.NotResources, err = dynamicStringSet(v)
+		case "principals":
+			stmt.Principals, err = expandIAMPolicyDocumentPrincipalConfigs(v)
+		case "not_principals":
+			stmt.NotPrincipals, err = expandIAMPolicyDocumentPrincipalConfigs(v)
+		case "condition":
+			stmt.Conditions, err = expandIAMPolicyDocumentConditionConfigs(v)
+		}
+
+		if err != nil {
+			return stmt, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return stmt, nil
+}
+
+func expandIAMPolicyDocumentPrincipalConfigs(v attr.Value) ([]tfiam.PolicyDocumentPrincipalConfig, error) {
+	elems, err := dynamicElements(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var principals []tfiam.PolicyDocumentPrincipalConfig
+
+	for i, v := range elems {
+		attrs, err := dynamicAttributes(v, "type", "identifiers")
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		var principal tfiam.PolicyDocumentPrincipalConfig
+		if principal.Type, err = dynamicRequiredString(attrs, "type"); err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		if principal.Identifiers, err = dynamicRequiredStrings(attrs, "identifiers"); err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		principal.Identifiers = compactStrings(principal.Identifiers)
+
+		principals = append(principals, principal)
+	}
+
+	return principals, nil
+}
+
+func expandIAMPolicyDocumentConditionConfigs(v attr.Value) ([]tfiam.PolicyDocumentConditionConfig, error) {
+	elems, err := dynamicElements(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var conditions []tfiam.PolicyDocumentConditionConfig
+
+	for i, v := range elems {
+		attrs, err := dynamicAttributes(v, "test", "variable", "values")
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		var condition tfiam.PolicyDocumentConditionConfig
+		if condition.Test, err = dynamicRequiredString(attrs, "test"); err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		if condition.Variable, err = dynamicRequiredString(attrs, "variable"); err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		if condition.Values, err = dynamicRequiredStrings(attrs, "values"); err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+// dynamicAttributes returns the non-null attributes of an object or map value.
+// Attribute names other than those allowed are rejected.
+func dynamicAttributes(v attr.Value, allowed ...string) (map[string]attr.Value, error) {
+	var in map[string]attr.Value
+
+	switch v := unwrapDynamic(v).(type) {
+	case basetypes.ObjectValue:
+		in = v.Attributes()
+	case basetypes.MapValue:
+		in = v.Elements()
+	default:
+		return nil, fmt.Errorf("expected an object, got %s", dynamicTypeName(v))
+	}
+
+	out := make(map[string]attr.Value, len(in))
+	for name, v := range in {
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("unsupported attribute %q; expected one of %s", name, strings.Join(allowed, ", "))
+		}
+		if v = unwrapDynamic(v); v.IsNull() {
+			continue
+		}
+		out[name] = v
+	}
+
+	return out, nil
+}
+
+// dynamicElements returns the elements of a list, set or tuple value.
+func dynamicElements(v attr.Value) ([]attr.Value, error) {
+	switch v := unwrapDynamic(v).(type) {
+	case basetypes.ListValue:
+		return v.Elements(), nil
+	case basetypes.SetValue:
+		return v.Elements(), nil
+	case basetypes.TupleValue:
+		return v.Elements(), nil
+	default:
+		return nil, fmt.Errorf("expected a list, got %s", dynamicTypeName(v))
+	}
+}
+
+func dynamicString(v attr.Value) (string, error) {
+	s, ok := unwrapDynamic(v).(basetypes.StringValue)
+	if !ok {
+		return "", fmt.Errorf("expected a string, got %s", dynamicTypeName(v))
+	}
+
+	return s.ValueString(), nil
+}
+
+// dynamicStrings returns the values of a list, set or tuple of strings.
+// A single string is treated as a list of one element.
+func dynamicStrings(v attr.Value) ([]string, error) {
+	if s, ok := unwrapDynamic(v).(basetypes.StringValue); ok {
+		return []string{s.ValueString()}, nil
+	}
+
+	elems, err := dynamicElements(v)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, 0, len(elems))
+	for i, v := range elems {
+		s, err := dynamicString(v)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		out = append(out, s)
+	}
+
+	return out, nil
+}
+
+// dynamicStringSet is dynamicStrings with duplicate values removed, matching the
+// set semantics of the equivalent aws_iam_policy_document data source arguments.
+func dynamicStringSet(v attr.Value) ([]string, error) {
+	s, err := dynamicStrings(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return compactStrings(s), nil
+}
+
+func compactStrings(s []string) []string {
+	slices.Sort(s)
+	return slices.Compact(s)
+}
+
+func dynamicRequiredString(attrs map[string]attr.Value, name string) (string, error) {
+	v, ok := attrs[name]
+	if !ok {
+		return "", fmt.Errorf("missing required attribute %q", name)
+	}
+
+	s, err := dynamicString(v)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+
+	return s, nil
+}
+
+func dynamicRequiredStrings(attrs map[string]attr.Value, name string) ([]string, error) {
+	v, ok := attrs[name]
+	if !ok {
+		return nil, fmt.Errorf("missing required attribute %q", name)
+	}
+
+	s, err := dynamicStrings(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return s, nil
+}
+
+func unwrapDynamic(v attr.Value) attr.Value {
+	for {
+		d, ok := v.(basetypes.DynamicValue)
+		if !ok || d.IsNull() || d.IsUnknown() || d.IsUnderlyingValueNull() {
+			return v
+		}
+		v = d.UnderlyingValue()
+	}
+}
+
+func dynamicTypeName(v attr.Value) string {
+	if v == nil {
+		return "null"
+	}
+
+	return unwrapDynamic(v).Type(context.Background()).String()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyDocumentFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyDocumentFunctionConfig(`{
+    statement = [
+      {
+        sid       = "AllowRead"
+        actions   = ["s3:ListBucket", "s3:GetObject"]
+        resources = ["arn:aws:s3:::example/&{aws:username}/*"]
+        principals = [{
+          type        = "AWS"
+          identifiers = ["arn:aws:iam::444455556666:root"]
+        }]
+        condition = [{
+          test     = "Bool"
+          variable = "aws:SecureTransport"
+          values   = ["true"]
+        }]
+      },
+    ]
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"AllowRead","Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":"arn:aws:s3:::example/${aws:username}/*","Principal":{"AWS":"arn:aws:iam::444455556666:root"},"Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyDocumentFunction_override(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyDocumentFunctionConfig(`{
+    policy_id = "example"
+    statement = [
+      {
+        sid       = "A"
+        effect    = "Deny"
+        actions   = ["s3:*"]
+        resources = ["*"]
+      },
+    ]
+    override_policy_documents = [
+      jsonencode({
+        Statement = [{
+          Sid      = "A"
+          Effect   = "Allow"
+          Action   = "s3:GetObject"
+          Resource = "*"
+        }]
+      }),
+    ]
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyDocumentFunction_invalidAttribute(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyDocumentFunctionConfig(`{ statement = [{ action = ["s3:*"] }] }`),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*attribute[\s\n]*"action"`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyDocumentFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyDocumentFunctionConfig(`{ statement = [{ sid = "A", actions = ["s3:*"] }, { sid = "A", actions = ["ec2:*"] }] }`),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyDocumentFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_document(%[1]s)
+}
+`, arg)
+}
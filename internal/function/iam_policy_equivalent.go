@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEquivalentFunction{}
+
+func NewIAMPolicyEquivalentFunction() function.Function {
+	return &iamPolicyEquivalentFunction{}
+}
+
+type iamPolicyEquivalentFunction struct{}
+
+func (f iamPolicyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equivalent"
+}
+
+func (f iamPolicyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equivalent Function",
+		MarkdownDescription: "Compares two IAM policy documents and returns whether they are equivalent, " +
+			"ignoring whitespace and the ordering of statements and values.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy1",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "policy2",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy1, policy2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy1, &policy2))
+	if resp.Error != nil {
+		return
+	}
+
+	// verify.PolicyStringsEquivalent treats malformed JSON as not equivalent.
+	// Surface it as an error instead so that typos are not silently masked.
+	for i, policy := range []string{policy1, policy2} {
+		if policy := strings.TrimSpace(policy); policy != "" && !json.Valid([]byte(policy)) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), fmt.Sprintf("policy%d is not valid JSON", i+1)))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(policy1, policy2)))
+}
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket":             types.StringType,
+	"key":                types.StringType,
+	"version_id":         types.StringType,
+	"region":             types.StringType,
+	"account_id":         types.StringType,
+	"access_point_name":  types.StringType,
+	"access_point_alias": types.StringType,
+	"outpost_id":         types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI, HTTPS URL or ARN into its constituent parts. " +
+			"Supports s3:// URIs, virtual-hosted-style and path-style URLs, access point ARNs and S3 on Outposts ARNs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI, HTTPS URL or ARN to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket":             types.StringValue(parts.bucket),
+		"key":                types.StringValue(parts.key),
+		"version_id":         types.StringValue(parts.versionID),
+		"region":             types.StringValue(parts.region),
+		"account_id":         types.StringValue(parts.accountID),
+		"access_point_name":  types.StringValue(parts.accessPointName),
+		"access_point_alias": types.StringValue(parts.accessPointAlias),
+		"outpost_id":         types.StringValue(parts.outpostID),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+const (
+	s3URIScheme = "s3://"
+
+	// Suffixes of access point aliases, which may be used in place of a bucket name.
+	s3AccessPointAliasSuffix         = "-s3alias"
+	s3OutpostsAccessPointAliasSuffix = "--op-s3"
+
+	s3ExternalEndpointRegion = "us-east-1"
+)
+
+var (
+	// Same bucket name pattern as validators.S3URI.
+	s3BucketNameRegexp = regexache.MustCompile(`^[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9]$`)
+	s3RegionRegexp     = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+	// Matches the S3 endpoint label of a hostname, e.g. "s3", "s3-fips", "s3-accesspoint",
+	// "s3-external-1" or the legacy dash-region form "s3-us-west-2".
+	s3EndpointLabelRegexp = regexache.MustCompile(`^s3(?:-(accesspoint-fips|accesspoint|fips|external-1))?(?:-([a-z]{2}(?:-[a-z]+)+-\d+))?$`)
+)
+
+type s3URIParts struct {
+	bucket           string
+	key              string
+	versionID        string
+	region           string
+	accountID        string
+	accessPointName  string
+	accessPointAlias string
+	outpostID        string
+}
+
+// parseS3URI decomposes an S3 location in any of its common forms.
+func parseS3URI(s string) (*s3URIParts, error) {
+	switch {
+	case strings.HasPrefix(s, s3URIScheme):
+		return parseS3SchemeURI(s)
+	case arn.IsARN(s):
+		return parseS3ARN(s)
+	case strings.HasPrefix(s, "https://"), strings.HasPrefix(s, "http://"):
+		return parseS3URL(s)
+	default:
+		return nil, fmt.Errorf("%q is not a supported S3 URI; expected an s3:// URI, an HTTPS URL or an ARN", s)
+	}
+}
+
+func parseS3SchemeURI(s string) (*s3URIParts, error) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(s, s3URIScheme), "/")
+
+	parts := &s3URIParts{key: key}
+	if err := parts.setBucket(bucket); err != nil {
+		return nil, fmt.Errorf("%q is not a valid S3 URI: %w", s, err)
+	}
+
+	return parts, nil
+}
+
+func parseS3ARN(s string) (*s3URIParts, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := &s3URIParts{
+		region:    v.Region,
+		accountID: v.AccountID,
+	}
+
+	switch v.Service {
+	case "s3":
+		// arn:aws:s3:::bucket[/key]
+		// arn:aws:s3:region:account-id:accesspoint/name[/object/key]
+		if name, ok := strings.CutPrefix(v.Resource, "accesspoint/"); ok {
+			name, key, _ := strings.Cut(name, "/object/")
+			parts.accessPointName, parts.key = name, key
+		} else {
+			bucket, key, _ := strings.Cut(v.Resource, "/")
+			if err := parts.setBucket(bucket); err != nil {
+				return nil, fmt.Errorf("%q is not a valid S3 ARN: %w", s, err)
+			}
+			parts.key = key
+		}
+	case "s3-outposts":
+		// arn:aws:s3-outposts:region:account-id:outpost/outpost-id/bucket/name
+		// arn:aws:s3-outposts:region:account-id:outpost/outpost-id/accesspoint/name[/object/key]
+		resource, ok := strings.CutPrefix(v.Resource, "outpost/")
+		if !ok {
+			return nil, fmt.Errorf("%q is not a valid S3 on Outposts ARN: resource must begin with \"outpost/\"", s)
+		}
+		outpostID, resource, _ := strings.Cut(resource, "/")
+		parts.outpostID = outpostID
+
+		switch typ, name, _ := strings.Cut(resource, "/"); typ {
+		case "bucket":
+			parts.bucket = name
+		case "accesspoint":
+			name, key, _ := strings.Cut(name, "/object/")
+			parts.accessPointName, parts.key = name, key
+		default:
+			return nil, fmt.Errorf("%q is not a valid S3 on Outposts ARN: unsupported resource type %q", s, typ)
+		}
+	default:
+		return nil, fmt.Errorf("%q is not an S3 ARN: service must be \"s3\" or \"s3-outposts\"", s)
+	}
+
+	return parts, nil
+}
+
+func parseS3URL(s string) (*s3URIParts, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	host, ok := strings.CutSuffix(u.Hostname(), ".amazonaws.com")
+	if !ok {
+		host, ok = strings.CutSuffix(u.Hostname(), ".amazonaws.com.cn")
+	}
+	if !ok {
+		return nil, fmt.Errorf("%q is not an S3 URL: unsupported host %q", s, u.Hostname())
+	}
+
+	parts := &s3URIParts{
+		versionID: u.Query().Get("versionId"),
+	}
+
+	// Work from the right of the hostname: [bucket.]s3[-qualifier][-region][.dualstack][.region]
+	labels := strings.Split(host, ".")
+	if n := len(labels); n > 0 && s3RegionRegexp.MatchString(labels[n-1]) {
+		parts.region = labels[n-1]
+		labels = labels[:n-1]
+	}
+	if n := len(labels); n > 0 && labels[n-1] == "dualstack" {
+		labels = labels[:n-1]
+	}
+
+	n := len(labels)
+	if n == 0 {
+		return nil, fmt.Errorf("%q is not an S3 URL: unsupported host %q", s, u.Hostname())
+	}
+	m := s3EndpointLabelRegexp.FindStringSubmatch(labels[n-1])
+	if m == nil {
+		return nil, fmt.Errorf("%q is not an S3 URL: unsupported host %q", s, u.Hostname())
+	}
+	qualifier, region := m[1], m[2]
+	if region != "" {
+		parts.region = region
+	}
+	if qualifier == "external-1" {
+		parts.region = s3ExternalEndpointRegion
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+	prefix := strings.Join(labels[:n-1], ".")
+
+	switch {
+	case strings.HasPrefix(qualifier, "accesspoint"):
+		// https://name-account-id.s3-accesspoint.region.amazonaws.com/key
+		i := strings.LastIndex(prefix, "-")
+		if i < 0 {
+			return nil, fmt.Errorf("%q is not a valid S3 access point URL: host must begin with \"<name>-<account-id>\"", s)
+		}
+		parts.accessPointName, parts.accountID = prefix[:i], prefix[i+1:]
+		parts.key = path
+	case prefix != "":
+		// Virtual-hosted-style: https://bucket.s3.region.amazonaws.com/key
+		if err := parts.setBucket(prefix); err != nil {
+			return nil, fmt.Errorf("%q is not a valid S3 URL: %w", s, err)
+		}
+		parts.key = path
+	default:
+		// Path-style: https://s3.region.amazonaws.com/bucket/key
+		bucket, key, _ := strings.Cut(path, "/")
+		if err := parts.setBucket(bucket); err != nil {
+			return nil, fmt.Errorf("%q is not a valid S3 URL: %w", s, err)
+		}
+		parts.key = key
+	}
+
+	return parts, nil
+}
+
+// setBucket sets either the bucket name or, if the value is an access point alias, the alias.
+func (p *s3URIParts) setBucket(v string) error {
+	if !s3BucketNameRegexp.MatchString(v) {
+		return fmt.Errorf("invalid bucket name %q", v)
+	}
+
+	if strings.HasSuffix(v, s3AccessPointAliasSuffix) || strings.HasSuffix(v, s3OutpostsAccessPointAliasSuffix) {
+		p.accessPointAlias = v
+	} else {
+		p.bucket = v
+	}
+
+	return nil
+}
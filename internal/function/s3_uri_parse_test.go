@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction_s3Scheme(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://amzn-s3-demo-bucket/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "amzn-s3-demo-bucket"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("region", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_virtualHostedStyle(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://amzn-s3-demo-bucket.s3.us-west-2.amazonaws.com/path/object%20name.txt?versionId=abc123"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "amzn-s3-demo-bucket"),
+					resource.TestCheckOutput("key", "path/object name.txt"),
+					resource.TestCheckOutput("version_id", "abc123"),
+					resource.TestCheckOutput("region", "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_pathStyle(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://s3.eu-west-1.amazonaws.com/amzn-s3-demo-bucket/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "amzn-s3-demo-bucket"),
+					resource.TestCheckOutput("key", "object.txt"),
+					resource.TestCheckOutput("region", "eu-west-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_accessPointAlias(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://my-access-point-hrzrlukc5m36ft7okagglf3gmwluquse1b-s3alias/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", ""),
+					resource.TestCheckOutput("access_point_alias", "my-access-point-hrzrlukc5m36ft7okagglf3gmwluquse1b-s3alias"),
+					resource.TestCheckOutput("key", "object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_accessPointARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3:us-west-2:444455556666:accesspoint/example/object/path/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("access_point_name", "example"),
+					resource.TestCheckOutput("key", "path/object.txt"),
+					resource.TestCheckOutput("region", "us-west-2"),
+					resource.TestCheckOutput("account_id", "444455556666"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_outpostsARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("arn:aws:s3-outposts:us-west-2:444455556666:outpost/op-01ac5d28a6a232904/bucket/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "example"),
+					resource.TestCheckOutput("outpost_id", "op-01ac5d28a6a232904"),
+					resource.TestCheckOutput("region", "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example.com/object.txt"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*an[\s\n]*S3[\s\n]*URL`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  parts = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "bucket" {
+  value = local.parts.bucket
+}
+
+output "key" {
+  value = local.parts.key
+}
+
+output "version_id" {
+  value = local.parts.version_id
+}
+
+output "region" {
+  value = local.parts.region
+}
+
+output "account_id" {
+  value = local.parts.account_id
+}
+
+output "access_point_name" {
+  value = local.parts.access_point_name
+}
+
+output "access_point_alias" {
+  value = local.parts.access_point_alias
+}
+
+output "outpost_id" {
+  value = local.parts.outpost_id
+}
+`, arg)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// AWS reserves the first four and the last IP address in each subnet CIDR block.
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+	subnetReservedAddressCount = 5
+
+	// Subnet sizes supported by Amazon VPC.
+	subnetIPv4MinPrefixLength = 16
+	subnetIPv4MaxPrefixLength = 28
+	subnetIPv6MaxPrefixLength = 64
+	// IPv6 subnet prefix lengths must be a multiple of 4.
+	subnetIPv6PrefixLengthIncrement = 4
+)
+
+var subnetPlanResultAttrTypes = map[string]attr.Type{
+	"cidr_block":   types.StringType,
+	"usable_hosts": types.NumberType,
+}
+
+var _ function.Function = subnetPlanFunction{}
+
+func NewSubnetPlanFunction() function.Function {
+	return &subnetPlanFunction{}
+}
+
+type subnetPlanFunction struct{}
+
+func (f subnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_plan"
+}
+
+func (f subnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "subnet_plan Function",
+		MarkdownDescription: "Allocates non-overlapping subnet CIDR blocks of the requested prefix lengths from a VPC CIDR block " +
+			"and returns the number of usable host addresses in each after the five addresses reserved by AWS",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Prefix length of each subnet to allocate, in order",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: subnetPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f subnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var prefixLengths []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	subnets, err := planSubnets(cidrBlock, prefixLengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elementType := types.ObjectType{AttrTypes: subnetPlanResultAttrTypes}
+	elements := make([]attr.Value, 0, len(subnets))
+	for _, subnet := range subnets {
+		value := map[string]attr.Value{
+			"cidr_block":   types.StringValue(subnet.cidrBlock),
+			"usable_hosts": types.NumberValue(new(big.Float).SetInt(subnet.usableHosts)),
+		}
+
+		element, d := types.ObjectValue(subnetPlanResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		elements = append(elements, element)
+	}
+
+	result, d := types.ListValue(elementType, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type plannedSubnet struct {
+	cidrBlock   string
+	usableHosts *big.Int
+}
+
+// planSubnets allocates subnets of the specified prefix lengths, in order, from the VPC CIDR block.
+// Each subnet starts at the first address following the previous subnet that is aligned to the
+// subnet's size, so the same inputs always produce the same allocation.
+func planSubnets(cidrBlock string, prefixLengths []int64) ([]plannedSubnet, error) {
+	if err := inttypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	ip, ipnet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var addressBits, minPrefixLength, maxPrefixLength int
+	vpcPrefixLength, _ := ipnet.Mask.Size()
+	ipv4 := ip.To4() != nil
+
+	if ipv4 {
+		if err := verify.ValidateIPv4CIDRBlock(cidrBlock); err != nil {
+			return nil, err
+		}
+		addressBits = net.IPv4len * 8
+		minPrefixLength = max(vpcPrefixLength, subnetIPv4MinPrefixLength)
+		maxPrefixLength = subnetIPv4MaxPrefixLength
+	} else {
+		if err := verify.ValidateIPv6CIDRBlock(cidrBlock); err != nil {
+			return nil, err
+		}
+		addressBits = net.IPv6len * 8
+		minPrefixLength = vpcPrefixLength
+		maxPrefixLength = subnetIPv6MaxPrefixLength
+	}
+
+	if vpcPrefixLength > maxPrefixLength {
+		return nil, fmt.Errorf("%q is too small to contain a subnet; the maximum prefix length is /%d", cidrBlock, maxPrefixLength)
+	}
+
+	start := new(big.Int).SetBytes(ipAddressBytes(ipnet.IP, ipv4))
+	end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(addressBits-vpcPrefixLength)))
+	next := new(big.Int).Set(start)
+	subnets := make([]plannedSubnet, 0, len(prefixLengths))
+
+	for i, prefixLength := range prefixLengths {
+		if prefixLength < int64(minPrefixLength) || prefixLength > int64(maxPrefixLength) {
+			return nil, fmt.Errorf("prefix_lengths[%d]: /%d is not a valid subnet size for %q; it must be between /%d and /%d", i, prefixLength, cidrBlock, minPrefixLength, maxPrefixLength)
+		}
+		if !ipv4 && prefixLength%subnetIPv6PrefixLengthIncrement != 0 {
+			return nil, fmt.Errorf("prefix_lengths[%d]: /%d is not a valid IPv6 subnet size; it must be a multiple of %d", i, prefixLength, subnetIPv6PrefixLengthIncrement)
+		}
+
+		size := new(big.Int).Lsh(big.NewInt(1), uint(int64(addressBits)-prefixLength))
+
+		// Round up to the next multiple of the subnet size.
+		if rem := new(big.Int).Mod(next, size); rem.Sign() != 0 {
+			next.Add(next, new(big.Int).Sub(size, rem))
+		}
+
+		last := new(big.Int).Add(next, size)
+		if last.Cmp(end) > 0 {
+			return nil, fmt.Errorf("prefix_lengths[%d]: insufficient address space remaining in %q for a /%d subnet", i, cidrBlock, prefixLength)
+		}
+
+		subnet := net.IPNet{
+			IP:   bigIntToIP(next, ipv4),
+			Mask: net.CIDRMask(int(prefixLength), addressBits),
+		}
+		subnets = append(subnets, plannedSubnet{
+			cidrBlock:   subnet.String(),
+			usableHosts: new(big.Int).Sub(size, big.NewInt(subnetReservedAddressCount)),
+		})
+
+		next = last
+	}
+
+	return subnets, nil
+}
+
+func ipAddressBytes(ip net.IP, ipv4 bool) []byte {
+	if ipv4 {
+		return ip.To4()
+	}
+	return ip.To16()
+}
+
+func bigIntToIP(v *big.Int, ipv4 bool) net.IP {
+	n := net.IPv6len
+	if ipv4 {
+		n = net.IPv4len
+	}
+
+	return net.IP(v.FillBytes(make([]byte, n)))
+}
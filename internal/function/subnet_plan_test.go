@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestSubnetPlanFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetPlanFunctionConfig("10.0.0.0/16", "[24, 26, 24, 28]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_blocks", "10.0.0.0/24,10.0.1.0/26,10.0.2.0/24,10.0.3.0/28"),
+					resource.TestCheckOutput("usable_hosts", "251,59,251,11"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetPlanFunctionConfig("2600:1f14:abc:de00::/56", "[64, 60]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_blocks", "2600:1f14:abc:de00::/64,2600:1f14:abc:de10::/60"),
+					resource.TestCheckOutput("usable_hosts", "18446744073709551611,295147905179352825851"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetPlanFunctionConfig("10.0.0.1/16", "[24]"),
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetPlanFunctionConfig("10.0.0.0/16", "[29]"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*subnet[\s\n]*size`),
+			},
+		},
+	})
+}
+
+func TestSubnetPlanFunction_insufficientAddressSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetPlanFunctionConfig("10.0.0.0/24", "[25, 25, 28]"),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func testSubnetPlanFunctionConfig(cidrBlock, prefixLengths string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::subnet_plan(%[1]q, %[2]s)
+}
+
+output "cidr_blocks" {
+  value = join(",", local.subnets[*].cidr_block)
+}
+
+output "usable_hosts" {
+  value = join(",", local.subnets[*].usable_hosts)
+}
+`, cidrBlock, prefixLengths)
+}
@@ -65,6 +65,7 @@ func main() {
 			ephemeralResources:   make(map[string]ResourceDatum, 0),
 			frameworkDataSources: make(map[string]ResourceDatum, 0),
 			frameworkResources:   make(map[string]ResourceDatum, 0),
+			listResources:        make(map[string]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum, 0),
 			sdkResources:         make(map[string]ResourceDatum, 0),
 		}
@@ -97,6 +98,7 @@ func main() {
 			EphemeralResources:      v.ephemeralResources,
 			FrameworkDataSources:    v.frameworkDataSources,
 			FrameworkResources:      v.frameworkResources,
+			ListResources:           v.listResources,
 			SDKDataSources:          v.sdkDataSources,
 			SDKResources:            v.sdkResources,
 		}
@@ -173,6 +175,10 @@ type ResourceDatum struct {
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
+	TagsMaxTags                       int
+	TagsMaxKeyLength                  int
+	TagsMaxValueLength                int
+	TagsRestrictedCharacters          bool
 	ValidateRegionOverrideInPartition bool
 	IdentityAttributes                []identityAttribute
 	ARNIdentity                       bool
@@ -233,6 +239,7 @@ type ServiceDatum struct {
 	EphemeralResources      map[string]ResourceDatum
 	FrameworkDataSources    map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
+	ListResources           map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
 	GoImports               []goImport
@@ -261,6 +268,7 @@ type visitor struct {
 	ephemeralResources   map[string]ResourceDatum
 	frameworkDataSources map[string]ResourceDatum
 	frameworkResources   map[string]ResourceDatum
+	listResources        map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
@@ -372,6 +380,38 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					d.TagsResourceType = attr
 				}
 
+				if attr, ok := args.Keyword["maxTags"]; ok {
+					if n, err := strconv.Atoi(attr); err != nil || n < 1 {
+						v.errs = append(v.errs, fmt.Errorf("invalid maxTags value: %q at %s. Should be positive integer value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					} else {
+						d.TagsMaxTags = n
+					}
+				}
+
+				if attr, ok := args.Keyword["maxKeyLength"]; ok {
+					if n, err := strconv.Atoi(attr); err != nil || n < 1 {
+						v.errs = append(v.errs, fmt.Errorf("invalid maxKeyLength value: %q at %s. Should be positive integer value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					} else {
+						d.TagsMaxKeyLength = n
+					}
+				}
+
+				if attr, ok := args.Keyword["maxValueLength"]; ok {
+					if n, err := strconv.Atoi(attr); err != nil || n < 1 {
+						v.errs = append(v.errs, fmt.Errorf("invalid maxValueLength value: %q at %s. Should be positive integer value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					} else {
+						d.TagsMaxValueLength = n
+					}
+				}
+
+				if attr, ok := args.Keyword["restrictedCharacters"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid Tags/restrictedCharacters value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					} else {
+						d.TagsRestrictedCharacters = b
+					}
+				}
+
 			case "IdentityAttribute":
 				d.WrappedImport = true
 				if len(args.Positional) == 0 {
@@ -587,6 +627,30 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.errs = append(v.errs, fmt.Errorf("V60SDKv2Fix not supported for Framework Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				}
 
+			case "ListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.listResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.listResources[typeName] = d
+				}
+
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func validateInContextAssumeRoleARNInPartition(ctx context.Context, c *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.ValidateInContextAssumeRoleARNInPartition(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrAssumeRoleARN), "Invalid IAM Role ARN Value", err.Error())
+	}
+
+	return diags
+}
+
+type dataSourceInjectAssumeRoleARNAttributeInterceptor struct{}
+
+func (r dataSourceInjectAssumeRoleARNAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[datasource.SchemaRequest, datasource.SchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = dsschema.StringAttribute{
+				Optional:    true,
+				Description: names.TopLevelAssumeRoleARNAttributeDescription,
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
+			}
+		}
+	}
+
+	return diags
+}
+
+// dataSourceInjectAssumeRoleARNAttribute injects a top-level "assume_role_arn" attribute into a data source's schema.
+func dataSourceInjectAssumeRoleARNAttribute() dataSourceSchemaInterceptor {
+	return &dataSourceInjectAssumeRoleARNAttributeInterceptor{}
+}
+
+type dataSourceValidateAssumeRoleARNInterceptor struct{}
+
+func (r dataSourceValidateAssumeRoleARNInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		// As data sources have no ModifyPlan functionality we validate the per-resource IAM Role override value before R.
+		diags.Append(validateInContextAssumeRoleARNInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// dataSourceValidateAssumeRoleARN validates that the value of the top-level `assume_role_arn` attribute is in the configured AWS partition.
+func dataSourceValidateAssumeRoleARN() dataSourceCRUDInterceptor {
+	return &dataSourceValidateAssumeRoleARNInterceptor{}
+}
+
+type ephemeralResourceInjectAssumeRoleARNAttributeInterceptor struct{}
+
+func (r ephemeralResourceInjectAssumeRoleARNAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[ephemeral.SchemaRequest, ephemeral.SchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = erschema.StringAttribute{
+				Optional:    true,
+				Description: names.TopLevelAssumeRoleARNAttributeDescription,
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
+			}
+		}
+	}
+
+	return diags
+}
+
+// ephemeralResourceInjectAssumeRoleARNAttribute injects a top-level "assume_role_arn" attribute into an ephemeral resource's schema.
+func ephemeralResourceInjectAssumeRoleARNAttribute() ephemeralResourceSchemaInterceptor {
+	return &ephemeralResourceInjectAssumeRoleARNAttributeInterceptor{}
+}
+
+type ephemeralResourceValidateAssumeRoleARNInterceptor struct {
+	ephemeralResourceNoOpORCInterceptor
+}
+
+func (r ephemeralResourceValidateAssumeRoleARNInterceptor) open(ctx context.Context, opts interceptorOptions[ephemeral.OpenRequest, ephemeral.OpenResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		// As ephemeral resources have no ModifyPlan functionality we validate the per-resource IAM Role override value here.
+		diags.Append(validateInContextAssumeRoleARNInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// ephemeralResourceValidateAssumeRoleARN validates that the value of the top-level `assume_role_arn` attribute is in the configured AWS partition.
+func ephemeralResourceValidateAssumeRoleARN() ephemeralResourceORCInterceptor {
+	return &ephemeralResourceValidateAssumeRoleARNInterceptor{}
+}
+
+type resourceInjectAssumeRoleARNAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleARNAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = resourceattribute.AssumeRoleARN()
+		}
+	}
+
+	return diags
+}
+
+// resourceInjectAssumeRoleARNAttribute injects a top-level "assume_role_arn" attribute into a resource's schema.
+func resourceInjectAssumeRoleARNAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleARNAttributeInterceptor{}
+}
+
+type resourceValidateAssumeRoleARNInterceptor struct{}
+
+func (r resourceValidateAssumeRoleARNInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch when := opts.when; when {
+	case Before:
+		diags.Append(validateInContextAssumeRoleARNInPartition(ctx, c)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// resourceValidateAssumeRoleARN validates that the value of the top-level `assume_role_arn` attribute is in the configured AWS partition.
+func resourceValidateAssumeRoleARN() resourceModifyPlanInterceptor {
+	return &resourceValidateAssumeRoleARNInterceptor{}
+}
+
+type resourceForceNewIfAssumeRoleAccountChangesInterceptor struct{}
+
+func (r resourceForceNewIfAssumeRoleAccountChangesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return diags
+		}
+
+		// If the entire state is null, the resource is new.
+		if request.State.Raw.IsNull() {
+			return diags
+		}
+
+		var planRoleARN types.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &planRoleARN)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var stateRoleARN types.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &stateRoleARN)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// The resource would otherwise be updated in place in the new IAM Role's account.
+		if planRoleARN.IsUnknown() || c.AccountIDForAssumeRoleARN(ctx, planRoleARN.ValueString()) != c.AccountIDForAssumeRoleARN(ctx, stateRoleARN.ValueString()) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrAssumeRoleARN))
+		}
+	}
+
+	return diags
+}
+
+// resourceForceNewIfAssumeRoleAccountChanges forces resource replacement if the value of the top-level `assume_role_arn` attribute
+// changes to an IAM Role in another AWS account.
+func resourceForceNewIfAssumeRoleAccountChanges() resourceModifyPlanInterceptor {
+	return &resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type wrappedListResourceOptions struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext        contextFunc
+	isRegionOverrideEnabled bool
+	// resource is the Plugin SDK resource whose instances are listed.
+	resource *sdkschema.Resource
+	typeName string
+}
+
+// wrappedListResource serves a service package's list function as a Plugin Framework list resource.
+// The listed resource type is implemented using the Plugin SDK, so its schemas are sourced from the SDK resource.
+type wrappedListResource struct {
+	list func(context.Context, any) iter.Seq2[inttypes.ListResult, error]
+	meta *conns.AWSClient
+	opts wrappedListResourceOptions
+}
+
+func newWrappedListResource(list func(context.Context, any) iter.Seq2[inttypes.ListResult, error], opts wrappedListResourceOptions) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		list: list,
+		opts: opts,
+	}
+}
+
+var (
+	_ list.ListResourceWithConfigure    = &wrappedListResource{}
+	_ list.ListResourceWithRawV5Schemas = &wrappedListResource{}
+)
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+
+	if w.opts.isRegionOverrideEnabled {
+		response.Schema.Attributes[names.AttrRegion] = listschema.StringAttribute{
+			Optional:    true,
+			Description: "Region in which to list resources. Defaults to the Region set in the provider configuration.",
+		}
+	}
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = w.opts.resource.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = w.opts.resource.ProtoIdentitySchema(ctx)()
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var n int64
+		for v, err := range w.list(ctx, w.meta) {
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError(fmt.Sprintf("listing %s", w.opts.typeName), err.Error())
+				yield(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			if !yield(w.newListResult(ctx, request, v)) {
+				return
+			}
+
+			if n++; request.Limit > 0 && n >= request.Limit {
+				return
+			}
+		}
+	}
+}
+
+func (w *wrappedListResource) newListResult(ctx context.Context, request list.ListRequest, v inttypes.ListResult) list.ListResult {
+	result := request.NewListResult(ctx)
+	result.DisplayName = v.DisplayName
+
+	identity := make(map[string]string, len(v.Identity)+2)
+	if _, ok := w.opts.resource.Identity.SchemaMap()[names.AttrAccountID]; ok {
+		identity[names.AttrAccountID] = w.meta.AccountID(ctx)
+	}
+	if _, ok := w.opts.resource.Identity.SchemaMap()[names.AttrRegion]; ok {
+		identity[names.AttrRegion] = w.meta.Region(ctx)
+	}
+	for k, v := range v.Identity {
+		identity[k] = v
+	}
+	for k, v := range identity {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(k), types.StringValue(v))...)
+	}
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	if request.IncludeResource {
+		result.Diagnostics.Append(w.readResource(ctx, v.ID, &result)...)
+	}
+
+	return result
+}
+
+// readResource reads the listed resource's full state using the Plugin SDK resource.
+func (w *wrappedListResource) readResource(ctx context.Context, id string, result *list.ListResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributes := make(map[string]string)
+	if _, ok := w.opts.resource.SchemaMap()[names.AttrRegion]; ok {
+		attributes[names.AttrRegion] = w.meta.Region(ctx)
+	}
+	state, d := w.opts.resource.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{ID: id, Attributes: attributes}, w.meta)
+	for _, v := range d {
+		if v.Severity == sdkdiag.Error {
+			diags.AddError(v.Summary, v.Detail)
+		} else {
+			diags.AddWarning(v.Summary, v.Detail)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if state == nil {
+		// The resource was deleted after it was listed.
+		return diags
+	}
+
+	ty := w.opts.resource.CoreConfigSchema().ImpliedType()
+	val, err := state.AttrsAsObjectValue(ty)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading %s (%s)", w.opts.typeName, id), err.Error())
+		return diags
+	}
+
+	b, err := msgpack.Marshal(val, ty)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading %s (%s)", w.opts.typeName, id), err.Error())
+		return diags
+	}
+
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: b}).Unmarshal(result.Resource.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading %s (%s)", w.opts.typeName, id), err.Error())
+		return diags
+	}
+
+	result.Resource.Raw = raw
+
+	return diags
+}
@@ -16,12 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -42,11 +44,13 @@ var (
 	_ provider.Provider                       = &frameworkProvider{}
 	_ provider.ProviderWithFunctions          = &frameworkProvider{}
 	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 type frameworkProvider struct {
 	dataSources        []func() datasource.DataSource
 	ephemeralResources []func() ephemeral.EphemeralResource
+	listResources      []func() list.ListResource
 	primary            interface{ Meta() any }
 	resources          []func() resource.Resource
 	servicePackages    iter.Seq[conns.ServicePackage]
@@ -60,6 +64,7 @@ func NewProvider(ctx context.Context, primary interface{ Meta() any }) (provider
 	provider := &frameworkProvider{
 		dataSources:        make([]func() datasource.DataSource, 0),
 		ephemeralResources: make([]func() ephemeral.EphemeralResource, 0),
+		listResources:      make([]func() list.ListResource, 0),
 		primary:            primary,
 		resources:          make([]func() resource.Resource, 0),
 		servicePackages:    primary.Meta().(*conns.AWSClient).ServicePackages(ctx),
@@ -217,6 +222,21 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_concurrency": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the number of concurrent API calls to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "Maximum number of concurrent API calls to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service to limit, as used in the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -307,6 +327,16 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_autoscaling_group`, that tags are not defaulted on.",
+						},
+						"include_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_instance`, that tags are defaulted on. Defaults to all resource types.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -324,6 +354,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Description: "Configuration block with settings to ignore resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"key_prefixes": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -336,6 +371,87 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 							Description: "Resource tag keys to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
+						"value_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
+					},
+				},
+			},
+			"partition_credentials": schema.ListNestedBlock{
+				Description: "Configuration blocks with credentials for managing resources in other AWS partitions using the per-resource `region` argument.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_key": schema.StringAttribute{
+							Optional:    true,
+							Description: "The access key for API operations in the partition.",
+						},
+						"partition": schema.StringAttribute{
+							Required:    true,
+							Description: "The AWS partition, e.g. `aws-us-gov`.",
+						},
+						"profile": schema.StringAttribute{
+							Optional:    true,
+							Description: "The profile for API operations in the partition.",
+						},
+						"region": schema.StringAttribute{
+							Optional:    true,
+							Description: "The region used to configure the credentials. Defaults to a region in the partition.",
+						},
+						"secret_key": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The secret key for API operations in the partition.",
+						},
+						"token": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Session token for validating temporary credentials in the partition.",
+						},
+					},
+				},
+			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to enable adaptive client-side rate limiting of API calls to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"fail_fast": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Return an error instead of waiting when API calls to the service are being rate limited.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service to rate limit, as used in the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a tagging policy that the tags of all resources must conform to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_values": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of tag key to a regular expression that the tag's value must wholly match, e.g. `dev|staging|prod`.",
+						},
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Action to take when a resource's tags violate the policy. Valid values are `error` (the default) and `warn`.",
+						},
+						"key_pattern": schema.StringAttribute{
+							Optional:    true,
+							Description: "Regular expression that every tag key must wholly match.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tag keys that every resource must have.",
+						},
 					},
 				},
 			},
@@ -352,6 +468,7 @@ func (p *frameworkProvider) Configure(ctx context.Context, request provider.Conf
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -378,6 +495,14 @@ func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() eph
 	return slices.Clone(p.ephemeralResources)
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// All list resources must have unique type names.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return slices.Clone(p.listResources)
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -386,7 +511,13 @@ func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() eph
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchesFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyDocumentFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewSubnetPlanFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -420,9 +551,11 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				v := v.Region.Value()
 
 				interceptors = append(interceptors, dataSourceInjectRegionAttribute())
+				interceptors = append(interceptors, dataSourceInjectAssumeRoleARNAttribute())
 				if v.IsValidateOverrideInPartition {
 					interceptors = append(interceptors, dataSourceValidateRegion())
 				}
+				interceptors = append(interceptors, dataSourceValidateAssumeRoleARN())
 				interceptors = append(interceptors, dataSourceSetRegionInState())
 			}
 
@@ -434,7 +567,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
-					var overrideRegion string
+					var overrideRegion, overrideAssumeRoleARN string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						var target types.String
@@ -444,9 +577,16 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 						}
 
 						overrideRegion = target.ValueString()
+
+						diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+						if diags.HasError() {
+							return ctx, diags
+						}
+
+						overrideAssumeRoleARN = target.ValueString()
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion, overrideAssumeRoleARN)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -484,9 +624,11 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					v := v.Region.Value()
 
 					interceptors = append(interceptors, ephemeralResourceInjectRegionAttribute())
+					interceptors = append(interceptors, ephemeralResourceInjectAssumeRoleARNAttribute())
 					if v.IsValidateOverrideInPartition {
 						interceptors = append(interceptors, ephemeralResourceValidateRegion())
 					}
+					interceptors = append(interceptors, ephemeralResourceValidateAssumeRoleARN())
 					interceptors = append(interceptors, ephemeralResourceSetRegionInResult())
 				}
 
@@ -494,7 +636,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 					// bootstrapContext is run on all wrapped methods before any interceptors.
 					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
-						var overrideRegion string
+						var overrideRegion, overrideAssumeRoleARN string
 
 						if isRegionOverrideEnabled && getAttribute != nil {
 							var target types.String
@@ -504,9 +646,16 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 							}
 
 							overrideRegion = target.ValueString()
+
+							diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+
+							overrideAssumeRoleARN = target.ValueString()
 						}
 
-						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion, overrideAssumeRoleARN)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = fwflex.RegisterLogger(ctx)
@@ -523,6 +672,67 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 			}
 		}
 
+		if v, ok := sp.(conns.ServicePackageWithListResources); ok {
+			// List resources are served for Plugin SDK resource types, so their schemas come from the primary provider.
+			var resourcesMap map[string]*sdkschema.Resource
+			if v, ok := p.primary.(*sdkschema.Provider); ok {
+				resourcesMap = v.ResourcesMap
+			}
+
+			for _, v := range v.ListResources(ctx) {
+				typeName := v.TypeName
+				r, ok := resourcesMap[typeName]
+
+				if !ok {
+					errs = append(errs, fmt.Errorf("list resource (%s): managed resource type not found", typeName))
+					continue
+				}
+
+				var isRegionOverrideEnabled, isValidateOverrideInPartition bool
+				if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+					isRegionOverrideEnabled = true
+					isValidateOverrideInPartition = v.Value().IsValidateOverrideInPartition
+				}
+
+				opts := wrappedListResourceOptions{
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+						var overrideRegion string
+
+						if isRegionOverrideEnabled && getAttribute != nil {
+							var target types.String
+							diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+							if diags.HasError() {
+								return ctx, diags
+							}
+
+							overrideRegion = target.ValueString()
+						}
+
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion, "")
+						if c != nil {
+							if isValidateOverrideInPartition && overrideRegion != "" {
+								if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+									diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+									return ctx, diags
+								}
+							}
+
+							ctx = c.RegisterLogger(ctx)
+						}
+
+						return ctx, diags
+					},
+					isRegionOverrideEnabled: isRegionOverrideEnabled,
+					resource:                r,
+					typeName:                typeName,
+				}
+				p.listResources = append(p.listResources, func() list.ListResource {
+					return newWrappedListResource(v.List, opts)
+				})
+			}
+		}
+
 		for _, res := range sp.FrameworkResources(ctx) {
 			typeName := res.TypeName
 			inner, err := res.Factory(ctx)
@@ -543,11 +753,14 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				v := res.Region.Value()
 
 				interceptors = append(interceptors, resourceInjectRegionAttribute())
+				interceptors = append(interceptors, resourceInjectAssumeRoleARNAttribute())
 				if v.IsValidateOverrideInPartition {
 					interceptors = append(interceptors, resourceValidateRegion())
 				}
+				interceptors = append(interceptors, resourceValidateAssumeRoleARN())
 				interceptors = append(interceptors, resourceDefaultRegion())
 				interceptors = append(interceptors, resourceForceNewIfRegionChanges())
+				interceptors = append(interceptors, resourceForceNewIfAssumeRoleAccountChanges())
 				interceptors = append(interceptors, resourceSetRegionInState())
 				if res.Identity.HasInherentRegion() {
 					interceptors = append(interceptors, resourceImportRegionNoDefault())
@@ -581,7 +794,7 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
-					var overrideRegion string
+					var overrideRegion, overrideAssumeRoleARN string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						var target types.String
@@ -591,11 +804,18 @@ func (p *frameworkProvider) initialize(ctx context.Context) error {
 						}
 
 						overrideRegion = target.ValueString()
+
+						diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+						if diags.HasError() {
+							return ctx, diags
+						}
+
+						overrideAssumeRoleARN = target.ValueString()
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, res.Name, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, res.Name, overrideRegion, overrideAssumeRoleARN)
 					if c != nil {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx).ForResourceType(typeName), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
 						ctx = fwflex.RegisterLogger(ctx)
 					}
@@ -641,6 +861,10 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
@@ -676,6 +900,10 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s ephemeral resource", names.AttrRegion, typeName))
 						continue
 					}
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrAssumeRoleARN]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s ephemeral resource", names.AttrAssumeRoleARN, typeName))
+						continue
+					}
 				}
 			}
 		}
@@ -697,6 +925,10 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
@@ -6,6 +6,7 @@ package framework
 import (
 	"context"
 	"fmt"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -257,6 +258,25 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 		if planTags.IsWhollyKnown() {
 			allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 			diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+			// Check the tags to be sent to the service API against the service's tagging limits.
+			// System tags are not removed, so that configured tags with a reserved prefix are reported.
+			tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+			if violations := r.Constraints().Violations(tags); len(violations) > 0 {
+				diags.AddAttributeError(path.Root(names.AttrTags), "Tagging limits exceeded", fmt.Sprintf("%s exceed the service's tagging limits: %s", names.AttrTags, strings.Join(violations, "; ")))
+			}
+
+			// Check the new value against any provider configured tag_policy.
+			if tagPolicyConfig := c.TagPolicyConfig(ctx); tagPolicyConfig != nil {
+				if violations := tagPolicyConfig.Violations(allTags); len(violations) > 0 {
+					summary, detail := "Tag policy violation", fmt.Sprintf("%s violate the provider tag_policy: %s", names.AttrTagsAll, strings.Join(violations, "; "))
+					if tagPolicyConfig.WarnOnly() {
+						diags.AddAttributeWarning(path.Root(names.AttrTagsAll), summary, detail)
+					} else {
+						diags.AddAttributeError(path.Root(names.AttrTagsAll), summary, detail)
+					}
+				}
+			}
 		} else {
 			diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
@@ -63,6 +63,24 @@ func (h HTags) Enabled() bool {
 	return !tfunique.IsHandleNil(h.unwrap())
 }
 
+// Constraints returns the limits that the resource type's service places on its tags.
+func (h HTags) Constraints() tftags.Constraints {
+	constraints := tftags.DefaultConstraints()
+	v := h.value()
+	if v.MaxTags > 0 {
+		constraints.MaxTags = v.MaxTags
+	}
+	if v.MaxKeyLength > 0 {
+		constraints.MaxKeyLength = v.MaxKeyLength
+	}
+	if v.MaxValueLength > 0 {
+		constraints.MaxValueLength = v.MaxValueLength
+	}
+	constraints.RestrictedCharacters = v.RestrictedCharacters
+
+	return constraints
+}
+
 // If the service package has a generic resource list tags methods, call it.
 func (h HTags) ListTags(ctx context.Context, sp conns.ServicePackage, c *conns.AWSClient, identifier string) error {
 	var err error
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceValidateAssumeRoleARN() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				return c.ValidateInContextAssumeRoleARNInPartition(ctx)
+			}
+		}
+
+		return nil
+	})
+}
+
+func forceNewIfAssumeRoleAccountChanges() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Force resource replacement if the value of the top-level `assume_role_arn` attribute changes
+				// to an IAM Role in another AWS account, as the resource would otherwise be updated in place in the new account.
+				if d.Id() != "" && d.HasChange(names.AttrAssumeRoleARN) {
+					if !d.NewValueKnown(names.AttrAssumeRoleARN) {
+						return d.ForceNew(names.AttrAssumeRoleARN)
+					}
+					o, n := d.GetChange(names.AttrAssumeRoleARN)
+					if c.AccountIDForAssumeRoleARN(ctx, o.(string)) == c.AccountIDForAssumeRoleARN(ctx, n.(string)) {
+						return nil
+					}
+					return d.ForceNew(names.AttrAssumeRoleARN)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func dataSourceValidateAssumeRoleARN() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Read:
+				// As data sources have no CustomizeDiff functionality, we validate the per-resource IAM Role override value here.
+				if err := c.ValidateInContextAssumeRoleARNInPartition(ctx); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestForceNewIfAssumeRoleAccountChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new          string
+		expectRequiresNew bool
+	}{
+		"unchanged": {
+			old: "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+			new: "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+		},
+		"same account": {
+			old: "arn:aws:iam::222222222222:role/test1", //lintignore:AWSAT005
+			new: "arn:aws:iam::222222222222:role/test2", //lintignore:AWSAT005
+		},
+		"added in provider account": {
+			new: "arn:aws:iam::111111111111:role/test", //lintignore:AWSAT005
+		},
+		"removed in provider account": {
+			old: "arn:aws:iam::111111111111:role/test", //lintignore:AWSAT005
+		},
+		"other account": {
+			old:               "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+			new:               "arn:aws:iam::333333333333:role/test", //lintignore:AWSAT005
+			expectRequiresNew: true,
+		},
+		"added in other account": {
+			new:               "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+			expectRequiresNew: true,
+		},
+		"removed in other account": {
+			old:               "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+			expectRequiresNew: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			client := &conns.AWSClient{}
+			conns.SetAccountID(client, "111111111111")
+
+			interceptors := interceptorInvocations{
+				{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleAccountChanges(),
+				},
+			}
+			bootstrapContext := func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, error) {
+				return ctx, nil
+			}
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrAssumeRoleARN: attribute.AssumeRoleARN(),
+				},
+				CustomizeDiff: interceptedCustomizeDiffHandler(bootstrapContext, interceptors, nil),
+			}
+
+			state := &terraform.InstanceState{
+				ID:         "id",
+				Attributes: map[string]string{"id": "id"},
+				RawPlan: cty.ObjectVal(map[string]cty.Value{
+					names.AttrAssumeRoleARN: cty.StringVal(testCase.new),
+				}),
+			}
+			if testCase.old != "" {
+				state.Attributes[names.AttrAssumeRoleARN] = testCase.old
+			}
+			raw := make(map[string]any)
+			if testCase.new != "" {
+				raw[names.AttrAssumeRoleARN] = testCase.new
+			}
+
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := diff != nil && diff.RequiresNew(), testCase.expectRequiresNew; got != want {
+				t.Errorf("RequiresNew = %t, want %t", got, want)
+			}
+		})
+	}
+}
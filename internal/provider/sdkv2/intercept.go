@@ -175,7 +175,8 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 
 		// All other interceptors are run last to first.
 		reverse := tfslices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		// Keep any warnings from Before interceptors.
+		diags = append(diags, f(ctx, d, meta)...)
 
 		if diags.HasError() {
 			when = OnError
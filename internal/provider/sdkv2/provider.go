@@ -11,6 +11,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -67,6 +69,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_concurrency":               apiConcurrencySchema(),
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
@@ -83,6 +86,18 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Configuration block with settings to default resource tags across all resources.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"exclude_resource_types": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource types, e.g. `aws_autoscaling_group`, that tags are not defaulted on.",
+							},
+							"include_resource_types": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource types, e.g. `aws_instance`, that tags are defaulted on. Defaults to all resource types.",
+							},
 							"tags": {
 								Type:     schema.TypeMap,
 								Optional: true,
@@ -145,6 +160,24 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"key_patterns": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsValidRegExp,
+								},
+								Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+							},
+							"value_patterns": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringIsValidRegExp,
+								},
+								Description: "Regular expressions matching resource tag values to ignore across all resources.",
+							},
 						},
 					},
 				},
@@ -167,12 +200,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"partition_credentials": partitionCredentialsSchema(),
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"rate_limit": rateLimitSchema(),
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -249,6 +284,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS STS operations will take place. Examples\n" +
 						"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 				},
+				"tag_policy": tagPolicySchema(),
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -385,6 +421,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_concurrency"); ok {
+		apiConcurrency, dg := expandAPIConcurrency(ctx, cty.GetAttrPath("api_concurrency"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.APIConcurrency = apiConcurrency
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -469,6 +514,33 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("partition_credentials"); ok {
+		partitionCredentials, dg := expandPartitionCredentials(ctx, cty.GetAttrPath("partition_credentials"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.PartitionCredentials = partitionCredentials
+	}
+
+	if v, ok := d.GetOk("rate_limit"); ok {
+		rateLimits, dg := expandRateLimits(ctx, cty.GetAttrPath("rate_limit"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicyConfig, dg := expandTagPolicy(ctx, cty.GetAttrPath("tag_policy").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -540,17 +612,19 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				s := r.SchemaMap()
 
 				if _, ok := s[names.AttrRegion]; !ok {
-					// Inject a top-level "region" attribute.
-					regionSchema := attribute.Region()
+					// Inject top-level "region" and "assume_role_arn" attributes.
+					regionSchema, assumeRoleARNSchema := attribute.Region(), attribute.AssumeRoleARN()
 
 					if f := r.SchemaFunc; f != nil {
 						r.SchemaFunc = func() map[string]*schema.Schema {
 							s := f()
 							s[names.AttrRegion] = regionSchema
+							s[names.AttrAssumeRoleARN] = assumeRoleARNSchema
 							return s
 						}
 					} else {
 						r.Schema[names.AttrRegion] = regionSchema
+						r.Schema[names.AttrAssumeRoleARN] = assumeRoleARNSchema
 					}
 				}
 
@@ -561,6 +635,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						interceptor: dataSourceValidateRegion(),
 					})
 				}
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Read,
+					interceptor: dataSourceValidateAssumeRoleARN(),
+				})
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        After,
 					why:         Read,
@@ -578,15 +657,18 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			opts := wrappedDataSourceOptions{
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideRegion, overrideAssumeRoleARN string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok {
 							overrideRegion = region.(string)
 						}
+						if roleARN, ok := getAttribute(names.AttrAssumeRoleARN); ok {
+							overrideAssumeRoleARN = roleARN.(string)
+						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion, overrideAssumeRoleARN)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -641,8 +723,8 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				s := r.SchemaMap()
 
 				if _, ok := s[names.AttrRegion]; !ok {
-					// Inject a top-level "region" attribute.
-					regionSchema := attribute.Region()
+					// Inject top-level "region" and "assume_role_arn" attributes.
+					regionSchema, assumeRoleARNSchema := attribute.Region(), attribute.AssumeRoleARN()
 
 					// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
 					if r.UpdateWithoutTimeout == nil {
@@ -653,10 +735,12 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						r.SchemaFunc = func() map[string]*schema.Schema {
 							s := f()
 							s[names.AttrRegion] = regionSchema
+							s[names.AttrAssumeRoleARN] = assumeRoleARNSchema
 							return s
 						}
 					} else {
 						r.Schema[names.AttrRegion] = regionSchema
+						r.Schema[names.AttrAssumeRoleARN] = assumeRoleARNSchema
 					}
 				}
 
@@ -667,6 +751,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						interceptor: resourceValidateRegion(),
 					})
 				}
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: resourceValidateAssumeRoleARN(),
+				})
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before,
 					why:         CustomizeDiff,
@@ -685,6 +774,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: forceNewIfRegionChanges(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleAccountChanges(),
+				})
 				if resource.Identity.HasInherentRegion() {
 					interceptors = append(interceptors, resourceImportRegionNoDefault())
 				} else {
@@ -703,6 +797,21 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: setTagsAll(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateTags(resource.Tags),
+				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: enforceTagPolicy(),
+				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Create | Update,
+					interceptor: warnTagPolicy(),
+				})
 			}
 
 			if len(resource.Identity.Attributes) > 0 {
@@ -733,17 +842,20 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideRegion, overrideAssumeRoleARN string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok {
 							overrideRegion = region.(string)
 						}
+						if roleARN, ok := getAttribute(names.AttrAssumeRoleARN); ok {
+							overrideAssumeRoleARN = roleARN.(string)
+						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, overrideRegion, overrideAssumeRoleARN)
 					if c, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx).ForResourceType(typeName), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
 					}
 
@@ -775,6 +887,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := s[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
@@ -802,6 +918,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName))
 					continue
 				}
+				if _, ok := s[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
 			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
@@ -832,6 +952,132 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+func apiConcurrencySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the number of concurrent API calls to a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"limit": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "Maximum number of concurrent API calls to the service.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Service to limit, as used in the `endpoints` configuration block, e.g. `route53`.",
+				},
+			},
+		},
+	}
+}
+
+func partitionCredentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with credentials for managing resources in other AWS partitions using the per-resource `region` argument.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The access key for API operations in the partition.",
+				},
+				"partition": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The AWS partition, e.g. `aws-us-gov`.",
+				},
+				"profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The profile for API operations in the partition.",
+				},
+				"region": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The region used to configure the credentials. Defaults to a region in the partition.",
+				},
+				"secret_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The secret key for API operations in the partition.",
+				},
+				"token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Session token for validating temporary credentials in the partition.",
+				},
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to enable adaptive client-side rate limiting of API calls to a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fail_fast": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Return an error instead of waiting when API calls to the service are being rate limited.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Service to rate limit, as used in the `endpoints` configuration block, e.g. `route53`.",
+				},
+			},
+		},
+	}
+}
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with a tagging policy that the tags of all resources must conform to.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_values": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Map of tag key to a regular expression that the tag's value must wholly match, e.g. `dev|staging|prod`.",
+				},
+				"enforcement": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Action to take when a resource's tags violate the policy. Valid values are `error` (the default) and `warn`.",
+					ValidateDiagFunc: enum.Validate[tftags.PolicyEnforcement](),
+				},
+				"key_pattern": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Regular expression that every tag key must wholly match.",
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"required_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Tag keys that every resource must have.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -959,6 +1205,118 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func expandAPIConcurrency(_ context.Context, path cty.Path, tfList []any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiConcurrency := make(map[string]int)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		servicePackageName, dg := expandServicePackageName(path.IndexInt(i).GetAttr("service"), tfMap["service"].(string), apiConcurrency)
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			continue
+		}
+
+		apiConcurrency[servicePackageName] = tfMap["limit"].(int)
+	}
+
+	return apiConcurrency, diags
+}
+
+func expandRateLimits(_ context.Context, path cty.Path, tfList []any) (map[string]conns.ServiceRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rateLimits := make(map[string]conns.ServiceRateLimit)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		servicePackageName, dg := expandServicePackageName(path.IndexInt(i).GetAttr("service"), tfMap["service"].(string), rateLimits)
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			continue
+		}
+
+		rateLimits[servicePackageName] = conns.ServiceRateLimit{
+			FailFast: tfMap["fail_fast"].(bool),
+		}
+	}
+
+	return rateLimits, diags
+}
+
+func expandPartitionCredentials(_ context.Context, path cty.Path, tfList []any) (map[string]conns.PartitionCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	partitionCredentials := make(map[string]conns.PartitionCredentials)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		partitionID := tfMap["partition"].(string)
+		if _, ok := names.PartitionForID(partitionID); !ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("partition"), "Unsupported partition %q.", partitionID))
+			continue
+		}
+		if _, ok := partitionCredentials[partitionID]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("partition"), "Duplicate configuration for partition %q.", partitionID))
+			continue
+		}
+
+		region := tfMap[names.AttrRegion].(string)
+		if region != "" {
+			if got := names.PartitionForRegion(region).ID(); got != partitionID {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(names.AttrRegion), "Region %q is not in partition %q.", region, partitionID))
+				continue
+			}
+		}
+
+		partitionCredentials[partitionID] = conns.PartitionCredentials{
+			AccessKey: tfMap["access_key"].(string),
+			Profile:   tfMap["profile"].(string),
+			Region:    region,
+			SecretKey: tfMap["secret_key"].(string),
+			Token:     tfMap["token"].(string),
+		}
+	}
+
+	return partitionCredentials, diags
+}
+
+// expandServicePackageName returns the service package name for a service name or alias
+// that has not already been configured.
+func expandServicePackageName[V any](path cty.Path, service string, configured map[string]V) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	servicePackageName := service
+	if !slices.Contains(names.ProviderPackages(), servicePackageName) {
+		v, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			return "", append(diags, errs.NewInvalidValueAttributeErrorf(path, "Unsupported service %q.", service))
+		}
+		servicePackageName = v
+	}
+
+	if _, ok := configured[servicePackageName]; ok {
+		return "", append(diags, errs.NewInvalidValueAttributeErrorf(path, "Duplicate configuration for service %q.", service))
+	}
+
+	return servicePackageName, diags
+}
+
+// assumeRoleChainMaxDuration is the maximum session duration of an IAM Role assumed using role chaining.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_terms-and-concepts.html#iam-term-role-chaining.
+const assumeRoleChainMaxDuration = 1 * time.Hour
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -970,6 +1328,16 @@ func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result
 			if d.HasError() {
 				return result, diags
 			}
+			if i > 0 && x.Duration > assumeRoleChainMaxDuration {
+				return result, append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("duration"),
+					"assume_role %d of %d: role chaining limits the duration of chained IAM Role sessions to a maximum of 1 hour", i+1, len(tfList)))
+			}
+			for _, k := range x.TransitiveTagKeys {
+				if _, ok := x.Tags[k]; !ok {
+					return result, append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("transitive_tag_keys"),
+						"assume_role %d of %d: transitive tag key %q is not a session tag key", i+1, len(tfList), k))
+				}
+			}
 			result[i] = x
 			tflog.Info(ctx, "assume_role configuration set", map[string]any{
 				"tf_aws.assume_role.index":           i,
@@ -1082,16 +1450,66 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	}
 
 	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
+		defaultConfig := &tftags.DefaultConfig{
 			Tags: tftags.New(ctx, tags),
 		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+			slices.Sort(defaultConfig.ExcludeResourceTypes)
+		}
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			defaultConfig.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+			slices.Sort(defaultConfig.IncludeResourceTypes)
+		}
+
+		return defaultConfig
 	}
 
 	return nil
 }
 
+func expandTagPolicy(_ context.Context, path cty.Path, tfMap map[string]any) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tagPolicyConfig := &tftags.PolicyConfig{
+		Enforcement: tftags.PolicyEnforcementError,
+	}
+
+	if v, ok := tfMap["allowed_values"].(map[string]any); ok && len(v) > 0 {
+		tagPolicyConfig.AllowedValues = make(map[string]*regexp.Regexp, len(v))
+		for k, v := range flex.ExpandStringValueMap(v) {
+			re, err := tftags.CompilePolicyPattern(v)
+			if err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("allowed_values").IndexString(k), "Invalid regular expression: %s", err))
+				continue
+			}
+			tagPolicyConfig.AllowedValues[k] = re
+		}
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		tagPolicyConfig.Enforcement = tftags.PolicyEnforcement(v)
+	}
+
+	if v, ok := tfMap["key_pattern"].(string); ok && v != "" {
+		re, err := tftags.CompilePolicyPattern(v)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("key_pattern"), "Invalid regular expression: %s", err))
+		}
+		tagPolicyConfig.KeyPattern = re
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		tagPolicyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(tagPolicyConfig.RequiredKeys)
+	}
+
+	return tagPolicyConfig, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
+	var keyPatterns, valuePatterns []*regexp.Regexp
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -1100,6 +1518,12 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+			keyPatterns = expandIgnoreTagsPatterns(v)
+		}
+		if v, ok := tfMap["value_patterns"].(*schema.Set); ok {
+			valuePatterns = expandIgnoreTagsPatterns(v)
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -1120,14 +1544,17 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
+	// - Return nil when no keys, prefixes or patterns are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyPatterns) == 0 && len(valuePatterns) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		KeyPatterns:   keyPatterns,
+		ValuePatterns: valuePatterns,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -1137,3 +1564,17 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 
 	return ignoreConfig
 }
+
+// expandIgnoreTagsPatterns compiles the regular expressions in the specified set.
+// The patterns have already been validated by the schema.
+func expandIgnoreTagsPatterns(set *schema.Set) []*regexp.Regexp {
+	var patterns []*regexp.Regexp
+	values := flex.ExpandStringValueSet(set)
+	slices.Sort(values)
+
+	for _, v := range values {
+		patterns = append(patterns, regexache.MustCompile(v))
+	}
+
+	return patterns
+}
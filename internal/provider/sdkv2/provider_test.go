@@ -5,13 +5,20 @@ package sdkv2
 
 import (
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -236,6 +243,8 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
 		tags                  map[string]any
+		excludeResourceTypes  []any
+		includeResourceTypes  []any
 		envvars               map[string]string
 		expectedDefaultConfig *tftags.DefaultConfig
 	}{
@@ -266,6 +275,21 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}),
 			},
 		},
+		"config resource types": {
+			tags: map[string]any{
+				"Owner": "my-team",
+			},
+			excludeResourceTypes: []any{"aws_autoscaling_group", "aws_s3_object"},
+			includeResourceTypes: []any{"aws_instance"},
+			envvars:              map[string]string{},
+			expectedDefaultConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{
+					"Owner": "my-team",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group", "aws_s3_object"},
+				IncludeResourceTypes: []string{"aws_instance"},
+			},
+		},
 		"envvar and config": {
 			tags: map[string]any{
 				"Application": "foobar",
@@ -293,7 +317,9 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 			}
 
 			results := expandDefaultTags(ctx, map[string]any{
-				"tags": testcase.tags,
+				"exclude_resource_types": schema.NewSet(schema.HashString, testcase.excludeResourceTypes),
+				"include_resource_types": schema.NewSet(schema.HashString, testcase.includeResourceTypes),
+				"tags":                   testcase.tags,
 			})
 
 			if results == nil {
@@ -304,6 +330,389 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}
 			} else if !testcase.expectedDefaultConfig.TagsEqual(results.Tags) {
 				t.Errorf("Expected default tags config to be %v, got %v", testcase.expectedDefaultConfig, results)
+			} else if !slices.Equal(results.ExcludeResourceTypes, testcase.expectedDefaultConfig.ExcludeResourceTypes) || !slices.Equal(results.IncludeResourceTypes, testcase.expectedDefaultConfig.IncludeResourceTypes) {
+				t.Errorf("Expected default tags resource types to be %v, got %v", testcase.expectedDefaultConfig, results)
+			}
+		})
+	}
+}
+
+func TestExpandAPIConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("api_concurrency")
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]int
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]int{},
+		},
+		"service": {
+			tfList: []any{
+				map[string]any{"service": "route53", "limit": 5},
+				map[string]any{"service": "iam", "limit": 10},
+			},
+			expected: map[string]int{
+				names.Route53: 5,
+				names.IAM:     10,
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{"service": "transcribeservice", "limit": 2},
+			},
+			expected: map[string]int{
+				names.Transcribe: 2,
+			},
+		},
+		"unsupported": {
+			tfList: []any{
+				map[string]any{"service": "unknown", "limit": 2},
+			},
+			expected: map[string]int{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(0).GetAttr("service"), "Unsupported service %q.", "unknown"),
+			},
+		},
+		"duplicate": {
+			tfList: []any{
+				map[string]any{"service": "transcribe", "limit": 2},
+				map[string]any{"service": "transcribeservice", "limit": 3},
+			},
+			expected: map[string]int{
+				names.Transcribe: 2,
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("service"), "Duplicate configuration for service %q.", "transcribeservice"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandAPIConcurrency(ctx, path, testcase.tfList)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("assume_role")
+	testcases := map[string]struct {
+		tfList        []any
+		expected      []awsbase.AssumeRole
+		expectedDiags diag.Diagnostics
+	}{
+		"chain": {
+			tfList: []any{
+				map[string]any{
+					"role_arn":    "arn:aws:iam::111111111111:role/hub",
+					"duration":    "2h",
+					"external_id": "hub-external-id",
+				},
+				map[string]any{
+					"role_arn":            "arn:aws:iam::222222222222:role/security",
+					"duration":            "1h",
+					"external_id":         "security-external-id",
+					"tags":                map[string]any{"Team": "security", "CostCenter": "1234"},
+					"transitive_tag_keys": schema.NewSet(schema.HashString, []any{"Team"}),
+				},
+				map[string]any{
+					"role_arn": "arn:aws:iam::333333333333:role/workload",
+					"tags":     map[string]any{"Workload": "example"},
+				},
+			},
+			expected: []awsbase.AssumeRole{
+				{
+					RoleARN:    "arn:aws:iam::111111111111:role/hub",
+					Duration:   2 * time.Hour,
+					ExternalID: "hub-external-id",
+				},
+				{
+					RoleARN:           "arn:aws:iam::222222222222:role/security",
+					Duration:          1 * time.Hour,
+					ExternalID:        "security-external-id",
+					Tags:              map[string]string{"Team": "security", "CostCenter": "1234"},
+					TransitiveTagKeys: []string{"Team"},
+				},
+				{
+					RoleARN: "arn:aws:iam::333333333333:role/workload",
+					Tags:    map[string]string{"Workload": "example"},
+				},
+			},
+		},
+		"missing role_arn": {
+			tfList: []any{
+				map[string]any{"role_arn": "arn:aws:iam::111111111111:role/hub"},
+				map[string]any{"session_name": "example"},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(path.IndexInt(1), "role_arn"),
+			},
+		},
+		"chained duration": {
+			tfList: []any{
+				map[string]any{"role_arn": "arn:aws:iam::111111111111:role/hub", "duration": "2h"},
+				map[string]any{"role_arn": "arn:aws:iam::222222222222:role/security", "duration": "90m"},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("duration"),
+					"assume_role %d of %d: role chaining limits the duration of chained IAM Role sessions to a maximum of 1 hour", 2, 2),
+			},
+		},
+		"transitive tag key": {
+			tfList: []any{
+				map[string]any{"role_arn": "arn:aws:iam::111111111111:role/hub"},
+				map[string]any{
+					"role_arn":            "arn:aws:iam::222222222222:role/security",
+					"tags":                map[string]any{"Team": "security"},
+					"transitive_tag_keys": schema.NewSet(schema.HashString, []any{"Project"}),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("transitive_tag_keys"),
+					"assume_role %d of %d: transitive tag key %q is not a session tag key", 2, 2, "Project"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandAssumeRoles(ctx, path, testcase.tfList)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("rate_limit")
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.ServiceRateLimit
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.ServiceRateLimit{},
+		},
+		"service": {
+			tfList: []any{
+				map[string]any{"service": "route53", "fail_fast": false},
+				map[string]any{"service": "transcribeservice", "fail_fast": true},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.Route53:    {},
+				names.Transcribe: {FailFast: true},
+			},
+		},
+		"duplicate": {
+			tfList: []any{
+				map[string]any{"service": "route53", "fail_fast": false},
+				map[string]any{"service": "route53", "fail_fast": true},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.Route53: {},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("service"), "Duplicate configuration for service %q.", "route53"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandRateLimits(ctx, path, testcase.tfList)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandPartitionCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("partition_credentials")
+	partitionCredentials := func(partition, profile, region string) map[string]any {
+		return map[string]any{
+			"access_key": "",
+			"partition":  partition,
+			"profile":    profile,
+			"region":     region,
+			"secret_key": "",
+			"token":      "",
+		}
+	}
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.PartitionCredentials
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.PartitionCredentials{},
+		},
+		"partitions": {
+			tfList: []any{
+				partitionCredentials(endpoints.AwsUsGovPartitionID, "govcloud", ""),
+				partitionCredentials(endpoints.AwsCnPartitionID, "china", endpoints.CnNorthwest1RegionID),
+			},
+			expected: map[string]conns.PartitionCredentials{
+				endpoints.AwsUsGovPartitionID: {Profile: "govcloud"},
+				endpoints.AwsCnPartitionID:    {Profile: "china", Region: endpoints.CnNorthwest1RegionID},
+			},
+		},
+		"unsupported partition": {
+			tfList: []any{
+				partitionCredentials("aws-custom", "custom", ""),
+			},
+			expected: map[string]conns.PartitionCredentials{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(0).GetAttr("partition"), "Unsupported partition %q.", "aws-custom"),
+			},
+		},
+		"duplicate": {
+			tfList: []any{
+				partitionCredentials(endpoints.AwsUsGovPartitionID, "govcloud", ""),
+				partitionCredentials(endpoints.AwsUsGovPartitionID, "govcloud2", ""),
+			},
+			expected: map[string]conns.PartitionCredentials{
+				endpoints.AwsUsGovPartitionID: {Profile: "govcloud"},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(1).GetAttr("partition"), "Duplicate configuration for partition %q.", endpoints.AwsUsGovPartitionID),
+			},
+		},
+		"region not in partition": {
+			tfList: []any{
+				partitionCredentials(endpoints.AwsUsGovPartitionID, "govcloud", endpoints.UsWest2RegionID),
+			},
+			expected: map[string]conns.PartitionCredentials{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.IndexInt(0).GetAttr(names.AttrRegion), "Region %q is not in partition %q.", endpoints.UsWest2RegionID, endpoints.AwsUsGovPartitionID),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandPartitionCredentials(ctx, path, testcase.tfList)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("tag_policy").IndexInt(0)
+	testcases := map[string]struct {
+		tfMap         map[string]any
+		expected      *tftags.PolicyConfig
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfMap: map[string]any{},
+			expected: &tftags.PolicyConfig{
+				Enforcement: tftags.PolicyEnforcementError,
+			},
+		},
+		"full": {
+			tfMap: map[string]any{
+				"allowed_values": map[string]any{
+					"Environment": "dev|prod",
+				},
+				"enforcement":   "warn",
+				"key_pattern":   "[A-Z][A-Za-z]*",
+				"required_keys": schema.NewSet(schema.HashString, []any{"Owner", "CostCenter"}),
+			},
+			expected: &tftags.PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+				AllowedValues: map[string]*regexp.Regexp{
+					"Environment": regexp.MustCompile(`^(?:dev|prod)$`),
+				},
+				KeyPattern:  regexp.MustCompile(`^(?:[A-Z][A-Za-z]*)$`),
+				Enforcement: tftags.PolicyEnforcementWarn,
+			},
+		},
+		"invalid allowed values": {
+			tfMap: map[string]any{
+				"allowed_values": map[string]any{
+					"Environment": "dev|(prod",
+				},
+			},
+			expected: &tftags.PolicyConfig{
+				AllowedValues: map[string]*regexp.Regexp{},
+				Enforcement:   tftags.PolicyEnforcementError,
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(path.GetAttr("allowed_values").IndexString("Environment"), "Invalid regular expression: %s", "error parsing regexp: missing closing ): `^(?:dev|(prod)$`"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, diags := expandTagPolicy(ctx, path, testcase.tfMap)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(result, testcase.expected, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				if x == nil || y == nil {
+					return x == y
+				}
+				return x.String() == y.String()
+			})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
 	}
@@ -314,6 +723,8 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	testcases := map[string]struct {
 		keys                 []any
 		keyPrefixes          []any
+		keyPatterns          []any
+		valuePatterns        []any
 		envvars              map[string]string
 		expectedIgnoreConfig *tftags.IgnoreConfig
 	}{
@@ -398,6 +809,32 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 				KeyPrefixes: tftags.New(ctx, []any{"example1", "example2", "example3"}),
 			},
 		},
+		"config patterns": {
+			keyPatterns:   []any{`^scan:\d{4}-\d{2}-\d{2}:`, `^kubernetes\.io/cluster/`},
+			valuePatterns: []any{"scanner"},
+			envvars:       map[string]string{},
+			expectedIgnoreConfig: &tftags.IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`^scan:\d{4}-\d{2}-\d{2}:`),
+				},
+				ValuePatterns: []*regexp.Regexp{
+					regexp.MustCompile("scanner"),
+				},
+			},
+		},
+		"envvar and config patterns": {
+			keyPatterns: []any{"^config"},
+			envvars: map[string]string{
+				tftags.IgnoreTagsKeysEnvVar: "env1",
+			},
+			expectedIgnoreConfig: &tftags.IgnoreConfig{
+				Keys: tftags.New(ctx, []any{"env1"}),
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile("^config"),
+				},
+			},
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest
@@ -410,15 +847,19 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 			}
 
 			results := expandIgnoreTags(ctx, map[string]any{
-				"keys":         schema.NewSet(schema.HashString, testcase.keys),
-				"key_prefixes": schema.NewSet(schema.HashString, testcase.keyPrefixes),
+				"keys":           schema.NewSet(schema.HashString, testcase.keys),
+				"key_prefixes":   schema.NewSet(schema.HashString, testcase.keyPrefixes),
+				"key_patterns":   schema.NewSet(schema.HashString, testcase.keyPatterns),
+				"value_patterns": schema.NewSet(schema.HashString, testcase.valuePatterns),
 			})
 
 			if results == nil && testcase.expectedIgnoreConfig != nil {
 				t.Errorf("Expected ignore tags config to be %v, got nil", testcase.expectedIgnoreConfig)
 			}
 
-			if diff := cmp.Diff(testcase.expectedIgnoreConfig, results); diff != "" {
+			if diff := cmp.Diff(testcase.expectedIgnoreConfig, results, cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				return x.String() == y.String()
+			})); diff != "" {
 				t.Errorf("Unexpected ignore_tags diff: %s", diff)
 			}
 		})
@@ -6,10 +6,13 @@ package sdkv2
 import (
 	"context"
 	"fmt"
+	"strings"
 	"unique"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -297,3 +300,95 @@ func setTagsAll() customizeDiffInterceptor {
 		return nil
 	})
 }
+
+// validateTags checks the tags to be sent to the service API against the service's tagging limits.
+func validateTags(servicePackageResourceTags unique.Handle[inttypes.ServicePackageResourceTags]) customizeDiffInterceptor {
+	h := interceptors.HTags(servicePackageResourceTags)
+
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Tags can only be checked once they are all known.
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				// System tags are not removed, so that configured tags with a reserved prefix are reported.
+				tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+				if violations := h.Constraints().Violations(tags); len(violations) > 0 {
+					return fmt.Errorf("%s exceed the service's tagging limits: %s", names.AttrTags, strings.Join(violations, "; "))
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// enforceTagPolicy checks the new value of the `tags_all` attribute against any provider configured tag_policy.
+// Terraform Plugin SDK v2 CustomizeDiff functions cannot return warnings, so violations of a policy that is not
+// enforced are reported by warnTagPolicy when the resource is created or updated.
+func enforceTagPolicy() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		tagPolicyConfig := c.TagPolicyConfig(ctx)
+		if tagPolicyConfig == nil || tagPolicyConfig.WarnOnly() {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// The policy can only be checked once all tags are known.
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+				if violations := tagPolicyConfig.Violations(allTags); len(violations) > 0 {
+					return fmt.Errorf("%s violate the provider tag_policy: %s", names.AttrTagsAll, strings.Join(violations, "; "))
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// warnTagPolicy returns a warning diagnostic when the resource's tags violate a provider configured tag_policy that is not enforced.
+// It applies to all SDKv2 resources with tags. Terraform Plugin SDK v2 cannot return warnings during planning,
+// so violations are only reported when the resource is created or updated.
+func warnTagPolicy() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		tagPolicyConfig := c.TagPolicyConfig(ctx)
+		if !tagPolicyConfig.WarnOnly() {
+			return diags
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Create, Update:
+				allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+				if violations := tagPolicyConfig.Violations(allTags); len(violations) > 0 {
+					diags = append(diags, errs.NewAttributeWarningDiagnostic(
+						cty.GetAttrPath(names.AttrTagsAll),
+						"Tag policy violation",
+						fmt.Sprintf("%s violate the provider tag_policy: %s", names.AttrTagsAll, strings.Join(violations, "; ")),
+					))
+				}
+			}
+		}
+
+		return diags
+	})
+}
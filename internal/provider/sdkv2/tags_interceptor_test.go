@@ -10,11 +10,15 @@ import (
 	"unique"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockService struct{}
@@ -86,7 +90,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "", "")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -164,3 +168,208 @@ func (d *resourceData) HasChanges(keys ...string) bool {
 func (d *resourceData) Identity() (*schema.IdentityData, error) {
 	return nil, nil
 }
+
+func TestTagPolicyInterceptors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		enforcement     tftags.PolicyEnforcement
+		tags            map[string]any
+		defaultTags     map[string]any
+		expectPlanError bool
+		expectWarnings  int
+	}{
+		"error compliant": {
+			enforcement: tftags.PolicyEnforcementError,
+			tags:        map[string]any{"Owner": "me"},
+		},
+		"error compliant with default tags": {
+			enforcement: tftags.PolicyEnforcementError,
+			defaultTags: map[string]any{"Owner": "me"},
+		},
+		"error violation": {
+			enforcement:     tftags.PolicyEnforcementError,
+			tags:            map[string]any{"Name": "test"},
+			expectPlanError: true,
+		},
+		"warn compliant": {
+			enforcement: tftags.PolicyEnforcementWarn,
+			tags:        map[string]any{"Owner": "me"},
+		},
+		"warn violation": {
+			enforcement:    tftags.PolicyEnforcementWarn,
+			tags:           map[string]any{"Name": "test"},
+			expectWarnings: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			client := &conns.AWSClient{}
+			conns.SetDefaultTagsConfig(client, expandDefaultTags(ctx, map[string]any{
+				"tags": testCase.defaultTags,
+			}))
+			conns.SetIgnoreTagsConfig(client, expandIgnoreTags(ctx, map[string]any{}))
+			conns.SetTagPolicyConfig(client, &tftags.PolicyConfig{
+				RequiredKeys: []string{"Owner"},
+				Enforcement:  testCase.enforcement,
+			})
+
+			interceptors := interceptorInvocations{
+				{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: enforceTagPolicy(),
+				},
+				{
+					when:        Before,
+					why:         Create | Update,
+					interceptor: warnTagPolicy(),
+				},
+			}
+			bootstrapContext := func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, error) {
+				return ctx, nil
+			}
+
+			var created bool
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					names.AttrTagsAll: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				CustomizeDiff: interceptedCustomizeDiffHandler(bootstrapContext, interceptors, nil),
+			}
+			create := interceptedCRUDHandler(bootstrapContext, interceptors, func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				created = true
+				d.SetId("test")
+				return nil
+			}, Create)
+
+			raw := map[string]any{}
+			if testCase.tags != nil {
+				raw[names.AttrTags] = testCase.tags
+			}
+			tags := cty.NullVal(cty.Map(cty.String))
+			if len(testCase.tags) > 0 {
+				m := make(map[string]cty.Value)
+				for k, v := range testCase.tags {
+					m[k] = cty.StringVal(v.(string))
+				}
+				tags = cty.MapVal(m)
+			}
+			rawPlan := cty.ObjectVal(map[string]cty.Value{
+				names.AttrTags:    tags,
+				names.AttrTagsAll: cty.UnknownVal(cty.Map(cty.String)),
+			})
+
+			// Plan.
+			_, err := r.Diff(ctx, &terraform.InstanceState{RawPlan: rawPlan}, terraform.NewResourceConfigRaw(raw), client)
+			if testCase.expectPlanError {
+				if err == nil {
+					t.Fatal("expected plan error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected plan error: %s", err)
+			}
+
+			// Apply.
+			diags := create(ctx, schema.TestResourceDataRaw(t, r.Schema, raw), client)
+			if diags.HasError() {
+				t.Fatalf("unexpected apply error: %v", diags)
+			}
+			if !created {
+				t.Error("expected resource to be created")
+			}
+			if got, want := len(sdkdiag.Warnings(diags)), testCase.expectWarnings; got != want {
+				t.Errorf("warnings = %d, want %d: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestValidateTagsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tags        map[string]string
+		expectError bool
+	}{
+		"valid": {
+			tags: map[string]string{"Owner": "me"},
+		},
+		"reserved prefix": {
+			tags:        map[string]string{"aws:Owner": "me"},
+			expectError: true,
+		},
+		"restricted characters": {
+			tags:        map[string]string{"Owner": "me*"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			client := &conns.AWSClient{}
+			conns.SetDefaultTagsConfig(client, expandDefaultTags(ctx, map[string]any{}))
+
+			interceptors := interceptorInvocations{
+				{
+					when: Before,
+					why:  CustomizeDiff,
+					interceptor: validateTags(unique.Make(inttypes.ServicePackageResourceTags{
+						IdentifierAttribute:  names.AttrARN,
+						RestrictedCharacters: true,
+					})),
+				},
+			}
+			bootstrapContext := func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, error) {
+				return ctx, nil
+			}
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				CustomizeDiff: interceptedCustomizeDiffHandler(bootstrapContext, interceptors, nil),
+			}
+
+			raw := make(map[string]any)
+			tags := make(map[string]cty.Value)
+			for k, v := range testCase.tags {
+				raw[k] = v
+				tags[k] = cty.StringVal(v)
+			}
+			rawPlan := cty.ObjectVal(map[string]cty.Value{
+				names.AttrTags: cty.MapVal(tags),
+			})
+
+			_, err := r.Diff(ctx, &terraform.InstanceState{RawPlan: rawPlan}, terraform.NewResourceConfigRaw(map[string]any{names.AttrTags: raw}), client)
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -394,7 +394,7 @@ func testAccCheckAppBundleExists(ctx context.Context, n string, v *awstypes.AppB
 
 func testAccCheckAppBundleExistsInRegion(ctx context.Context, n string, v *awstypes.AppBundle, region string) resource.TestCheckFunc {
 	// Push region into Context.
-	ctx = conns.NewResourceContext(ctx, "AppFabric", "aws_appfabric_app_bundle", region)
+	ctx = conns.NewResourceContext(ctx, "AppFabric", "aws_appfabric_app_bundle", region, "")
 	return testAccCheckAppBundleExists(ctx, n, v)
 }
 
@@ -23,7 +23,9 @@ import (
 
 // @SDKResource("aws_batch_scheduling_policy", name="Scheduling Policy")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/batch/types;types.SchedulingPolicyDetail")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceSchedulingPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSchedulingPolicyCreate,
@@ -31,10 +33,6 @@ func resourceSchedulingPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceSchedulingPolicyUpdate,
 		DeleteWithoutTimeout: resourceSchedulingPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -4,6 +4,7 @@
 package batch
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -26,7 +28,7 @@ import (
 const propagationTimeout = 2 * time.Minute
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -34,7 +36,7 @@ func RegisterSweepers() {
 		F: sweepComputeEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
 		Dependencies: []string{
@@ -42,12 +44,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
 
-	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
 		Name: "aws_batch_scheduling_policy",
 		F:    sweepSchedulingPolicies,
 		Dependencies: []string{
@@ -64,7 +66,6 @@ func sweepComputeEnvironments(region string) error {
 	}
 	input := &batch.DescribeComputeEnvironmentsInput{}
 	conn := client.BatchClient(ctx)
-	iamconn := client.IAMClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	var sweeperErrs *multierror.Error
 
@@ -84,6 +85,12 @@ func sweepComputeEnvironments(region string) error {
 		for _, v := range page.ComputeEnvironments {
 			name := aws.ToString(v.ComputeEnvironmentName)
 
+			r := resourceComputeEnvironment()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			var sweepResource sweep.Sweepable = sdk.NewSweepResource(r, d, client)
+
 			// Reference: https://aws.amazon.com/premiumsupport/knowledge-center/batch-invalid-compute-environment/
 			//
 			// When a Compute Environment becomes INVALID, it is typically because the associated
@@ -105,67 +112,71 @@ func sweepComputeEnvironments(region string) error {
 					continue
 				}
 
-				servicePrincipal := fmt.Sprintf("%s.%s", names.BatchEndpointID, client.DNSSuffix(ctx))
-				serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
-				serviceRolePolicyARN := arn.ARN{
-					AccountID: "aws",
-					Partition: client.Partition(ctx),
-					Resource:  "policy/service-role/AWSBatchServiceRole",
-					Service:   "iam",
-				}.String()
-
-				iamCreateRoleInput := &iam.CreateRoleInput{
-					AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
-					RoleName:                 aws.String(serviceRoleName),
-				}
+				sweepResource = sweep.WithPreDelete(sweepResource, func(ctx context.Context) error {
+					return recreateComputeEnvironmentServiceRole(ctx, client, name, serviceRoleARN)
+				})
+			}
 
-				_, err = iamconn.CreateRole(ctx, iamCreateRoleInput)
+			sweepResources = append(sweepResources, sweepResource)
+		}
+	}
 
-				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err))
-					continue
-				}
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
-				iamGetRoleInput := &iam.GetRoleInput{
-					RoleName: aws.String(serviceRoleName),
-				}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Batch Compute Environments (%s): %w", region, err))
+	}
 
-				waiter := iam.NewRoleExistsWaiter(iamconn)
-				err = waiter.Wait(ctx, iamGetRoleInput, propagationTimeout)
+	return sweeperErrs.ErrorOrNil()
+}
 
-				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err))
-					continue
-				}
+// recreateComputeEnvironmentServiceRole recreates the deleted IAM Role of an INVALID Batch Compute Environment so that it can be deleted.
+// The aws_iam_role sweeper deletes the recreated IAM Role.
+func recreateComputeEnvironmentServiceRole(ctx context.Context, client *conns.AWSClient, name string, serviceRoleARN arn.ARN) error {
+	iamconn := client.IAMClient(ctx)
+	servicePrincipal := fmt.Sprintf("%s.%s", names.BatchEndpointID, client.DNSSuffix(ctx))
+	serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
+	serviceRolePolicyARN := arn.ARN{
+		AccountID: "aws",
+		Partition: client.Partition(ctx),
+		Resource:  "policy/service-role/AWSBatchServiceRole",
+		Service:   "iam",
+	}.String()
+
+	iamCreateRoleInput := &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
+		RoleName:                 aws.String(serviceRoleName),
+	}
 
-				iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
-					PolicyArn: aws.String(serviceRolePolicyARN),
-					RoleName:  aws.String(serviceRoleName),
-				}
+	_, err := iamconn.CreateRole(ctx, iamCreateRoleInput)
 
-				_, err = iamconn.AttachRolePolicy(ctx, iamAttachRolePolicyInput)
+	if err != nil {
+		return fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
+	}
 
-				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err))
-					continue
-				}
-			}
+	iamGetRoleInput := &iam.GetRoleInput{
+		RoleName: aws.String(serviceRoleName),
+	}
 
-			r := resourceComputeEnvironment()
-			d := r.Data(nil)
-			d.SetId(name)
+	waiter := iam.NewRoleExistsWaiter(iamconn)
+	err = waiter.Wait(ctx, iamGetRoleInput, propagationTimeout)
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
-		}
+	if err != nil {
+		return fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
+	iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
+		PolicyArn: aws.String(serviceRolePolicyARN),
+		RoleName:  aws.String(serviceRoleName),
+	}
+
+	_, err = iamconn.AttachRolePolicy(ctx, iamAttachRolePolicyInput)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Batch Compute Environments (%s): %w", region, err))
+		return fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepJobDefinitions(region string) error {
@@ -5,6 +5,7 @@ package cloudformation
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 
@@ -153,24 +154,25 @@ func sweepStacks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 		for _, v := range page.StackSummaries {
 			name := aws.ToString(v.StackName)
-			input := cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
-
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, &input)
-
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
-			}
-
 			r := resourceStack()
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithPreDelete(sweep.NewSweepResource(r, d, client), func(ctx context.Context) error {
+				input := cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   aws.String(name),
+				}
+
+				log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
+				_, err := conn.UpdateTerminationProtection(ctx, &input)
+
+				if err != nil {
+					return fmt.Errorf("disabling termination protection for CloudFormation Stack (%s): %w", name, err)
+				}
+
+				return nil
+			}))
 		}
 	}
 
@@ -29,7 +29,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_distribution", name="Distribution")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 func resourceDistribution() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -52,7 +52,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_cloudfront_vpc_origin",
 			Name:     "VPC Origin",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
@@ -134,7 +135,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_cloudfront_distribution",
 			Name:     "Distribution",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
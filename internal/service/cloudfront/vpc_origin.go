@@ -30,7 +30,7 @@ import (
 )
 
 // @FrameworkResource("aws_cloudfront_vpc_origin", name="VPC Origin")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 func newVPCOriginResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &vpcOriginResource{}
 
@@ -89,7 +89,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_dynamodb_table",
 			Name:     "Table",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -117,7 +118,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_dynamodb_table_replica",
 			Name:     "Table Replica",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -22,12 +22,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
@@ -57,16 +57,6 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			input := dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			}
-			_, err := conn.UpdateTable(ctx, &input)
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
-			}
-
 			r := resourceTable()
 			d := r.Data(nil)
 			d.SetId(v)
@@ -79,7 +69,19 @@ func sweepTables(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithPreDelete(sweep.NewSweepResource(r, d, client), func(ctx context.Context) error {
+				input := dynamodb.UpdateTableInput{
+					DeletionProtectionEnabled: aws.Bool(false),
+					TableName:                 aws.String(v),
+				}
+				_, err := conn.UpdateTable(ctx, &input)
+
+				if err != nil {
+					log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
+				}
+
+				return nil
+			}))
 		}
 	}
 
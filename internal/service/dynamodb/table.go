@@ -45,7 +45,7 @@ const (
 )
 
 // @SDKResource("aws_dynamodb_table", name="Table")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dynamodb/types;types.TableDescription")
 func resourceTable() *schema.Resource {
 	//lintignore:R011
@@ -36,7 +36,7 @@ const (
 )
 
 // @SDKResource("aws_dynamodb_table_replica", name="Table Replica")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(altRegionProvider=true)
 func resourceTableReplica() *schema.Resource {
 	//lintignore:R011
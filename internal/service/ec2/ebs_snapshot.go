@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ebs_snapshot", name="EBS Snapshot")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceEBSSnapshot() *schema.Resource {
 	return &schema.Resource{
@@ -22,7 +22,7 @@ import (
 )
 
 // @SDKResource("aws_ebs_snapshot_copy", name="EBS Snapshot Copy")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceEBSSnapshotCopy() *schema.Resource {
 	return &schema.Resource{
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_ebs_snapshot_import", name="EBS Snapshot Import")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceEBSSnapshotImport() *schema.Resource {
 	return &schema.Resource{
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_ebs_volume", name="EBS Volume")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceEBSVolume() *schema.Resource {
 	return &schema.Resource{
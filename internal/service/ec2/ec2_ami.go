@@ -38,7 +38,7 @@ const (
 )
 
 // @SDKResource("aws_ami", name="AMI")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceAMI() *schema.Resource {
 	return &schema.Resource{
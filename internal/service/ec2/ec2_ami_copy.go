@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ami_copy", name="AMI")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceAMICopy() *schema.Resource {
 	return &schema.Resource{
@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ami_from_instance", name="AMI")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceAMIFromInstance() *schema.Resource {
 	return &schema.Resource{
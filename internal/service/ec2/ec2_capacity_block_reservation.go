@@ -30,7 +30,7 @@ import (
 )
 
 // @FrameworkResource("aws_ec2_capacity_block_reservation",name="Capacity Block Reservation")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func newCapacityBlockReservationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &capacityBlockReservationResource{}
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_capacity_reservation", name="Capacity Reservation")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceCapacityReservation() *schema.Resource {
 	return &schema.Resource{
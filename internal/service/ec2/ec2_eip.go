@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_eip", name="EIP")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceEIP() *schema.Resource {
 	return &schema.Resource{
@@ -30,7 +30,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_fleet", name="Fleet")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceFleet() *schema.Resource {
 	return &schema.Resource{
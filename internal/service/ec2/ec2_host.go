@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_host", name="Host")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceHost() *schema.Resource {
 	return &schema.Resource{
@@ -46,10 +46,12 @@ import (
 )
 
 // @SDKResource("aws_instance", name="Instance")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
+// @IdentityAttribute("id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
 // @Testing(generator=false)
+// @Testing(preIdentityVersion="6.4.0")
 func resourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -58,10 +60,6 @@ func resourceInstance() *schema.Resource {
 		UpdateWithoutTimeout: resourceInstanceUpdate,
 		DeleteWithoutTimeout: resourceInstanceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaVersion: 2,
 		MigrateState:  instanceMigrateState,
 		StateUpgraders: []schema.StateUpgrader{
@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_key_pair", name="Key Pair")
-// @Tags(identifierAttribute="key_pair_id")
+// @Tags(identifierAttribute="key_pair_id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceKeyPair() *schema.Resource {
 	//lintignore:R011
@@ -33,7 +33,7 @@ import (
 )
 
 // @SDKResource("aws_launch_template", name="Launch Template")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
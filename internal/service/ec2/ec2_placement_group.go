@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_placement_group", name="Placement Group")
-// @Tags(identifierAttribute="placement_group_id")
+// @Tags(identifierAttribute="placement_group_id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourcePlacementGroup() *schema.Resource {
 	return &schema.Resource{
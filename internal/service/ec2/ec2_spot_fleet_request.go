@@ -32,7 +32,7 @@ import (
 )
 
 // @SDKResource("aws_spot_fleet_request", name="Spot Fleet Request")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceSpotFleetRequest() *schema.Resource {
 	//lintignore:R011
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_spot_instance_request", name="Spot Instance Request")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceSpotInstanceRequest() *schema.Resource {
 	return &schema.Resource{
@@ -206,6 +206,7 @@ var (
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRulesBySecurityGroupID                    = findSecurityGroupRulesBySecurityGroupID
 	FindSecurityGroupVPCAssociationByTwoPartKey                = findSecurityGroupVPCAssociationByTwoPartKey
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
@@ -278,6 +279,7 @@ var (
 	ParseInstanceType                                          = parseInstanceType
 	ProtocolForValue                                           = protocolForValue
 	ProtocolStateFunc                                          = protocolStateFunc
+	RouteTableExclusiveRoutes                                  = routeTableExclusiveRoutes
 	SecurityGroupCollapseRules                                 = securityGroupCollapseRules
 	SecurityGroupExpandRules                                   = securityGroupExpandRules
 	SecurityGroupIPPermGather                                  = securityGroupIPPermGather
@@ -292,6 +294,7 @@ var (
 	UnsuccessfulItemError                                      = unsuccessfulItemError
 	UnsuccessfulItemsError                                     = unsuccessfulItemsError
 	UpdateTags                                                 = updateTags
+	ValidateSecurityGroupRuleConflicts                         = validateSecurityGroupRuleConflicts
 	VPCDHCPOptionsAssociationParseResourceID                   = vpcDHCPOptionsAssociationParseResourceID
 	VPCMigrateState                                            = vpcMigrateState
 	VPNGatewayRoutePropagationParseID                          = vpnGatewayRoutePropagationParseID
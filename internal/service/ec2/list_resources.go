@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_instance", name="Instance")
+func listInstances(ctx context.Context, meta any) iter.Seq2[inttypes.ListResult, error] {
+	return func(yield func(inttypes.ListResult, error) bool) {
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+		input := ec2.DescribeInstancesInput{}
+
+		pages := ec2.NewDescribeInstancesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(inttypes.ListResult{}, fmt.Errorf("listing EC2 Instances: %w", err))
+				return
+			}
+
+			for _, v := range page.Reservations {
+				for _, v := range v.Instances {
+					if v.State != nil && v.State.Name == awstypes.InstanceStateNameTerminated {
+						continue
+					}
+
+					id := aws.ToString(v.InstanceId)
+					result := inttypes.ListResult{
+						ID:          id,
+						DisplayName: cmp.Or(keyValueTags(ctx, v.Tags).Map()[tftags.NameTagKey], id),
+						Identity: map[string]string{
+							names.AttrID: id,
+						},
+					}
+					if !yield(result, nil) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// @ListResource("aws_security_group", name="Security Group")
+func listSecurityGroups(ctx context.Context, meta any) iter.Seq2[inttypes.ListResult, error] {
+	return func(yield func(inttypes.ListResult, error) bool) {
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+		input := ec2.DescribeSecurityGroupsInput{}
+
+		pages := ec2.NewDescribeSecurityGroupsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(inttypes.ListResult{}, fmt.Errorf("listing EC2 Security Groups: %w", err))
+				return
+			}
+
+			for _, v := range page.SecurityGroups {
+				id := aws.ToString(v.GroupId)
+				result := inttypes.ListResult{
+					ID:          id,
+					DisplayName: aws.ToString(v.GroupName),
+					Identity: map[string]string{
+						names.AttrID: id,
+					},
+				}
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// @ListResource("aws_vpc", name="VPC")
+func listVPCs(ctx context.Context, meta any) iter.Seq2[inttypes.ListResult, error] {
+	return func(yield func(inttypes.ListResult, error) bool) {
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+		input := ec2.DescribeVpcsInput{}
+
+		pages := ec2.NewDescribeVpcsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(inttypes.ListResult{}, fmt.Errorf("listing EC2 VPCs: %w", err))
+				return
+			}
+
+			for _, v := range page.Vpcs {
+				id := aws.ToString(v.VpcId)
+				result := inttypes.ListResult{
+					ID:          id,
+					DisplayName: cmp.Or(keyValueTags(ctx, v.Tags).Map()[tftags.NameTagKey], id),
+					Identity: map[string]string{
+						names.AttrID: id,
+					},
+				}
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
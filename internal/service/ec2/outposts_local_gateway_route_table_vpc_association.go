@@ -21,7 +21,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_local_gateway_route_table_vpc_association", name="Local Gateway Route Table VPC Association")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceLocalGatewayRouteTableVPCAssociation() *schema.Resource {
 	return &schema.Resource{
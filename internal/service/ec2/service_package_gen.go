@@ -80,6 +80,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Capacity Block Reservation",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -95,6 +97,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Instance Connect Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -128,12 +132,20 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Network Interface Permission",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newRouteTableRoutesExclusiveResource,
+			TypeName: "aws_route_table_routes_exclusive",
+			Name:     "Route Table Routes Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newVPCBlockPublicAccessExclusionResource,
 			TypeName: "aws_vpc_block_public_access_exclusion",
 			Name:     "VPC Block Public Access Exclusion",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -161,6 +173,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "VPC Route Server",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: "route_server_id",
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -170,6 +184,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "VPC Route Server Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: "route_server_endpoint_id",
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -179,6 +195,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "VPC Route Server Peer",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: "route_server_peer_id",
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -200,6 +218,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Security Group Egress Rule",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -209,9 +229,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Security Group Ingress Rule",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newSecurityGroupRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSecurityGroupVPCAssociationResource,
 			TypeName: "aws_vpc_security_group_vpc_association",
@@ -229,6 +257,29 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*inttypes.ServicePackageListResource {
+	return []*inttypes.ServicePackageListResource{
+		{
+			List:     listInstances,
+			TypeName: "aws_instance",
+			Name:     "Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			List:     listSecurityGroups,
+			TypeName: "aws_security_group",
+			Name:     "Security Group",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			List:     listVPCs,
+			TypeName: "aws_vpc",
+			Name:     "VPC",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
 	return []*inttypes.ServicePackageSDKDataSource{
 		{
@@ -775,6 +826,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "AMI",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -784,6 +837,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "AMI",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -793,6 +848,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "AMI",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -808,6 +865,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Customer Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -817,6 +876,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Network ACL",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -826,6 +887,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Route Table",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -835,6 +898,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Security Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -844,6 +909,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Subnet",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -853,6 +920,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Default VPC",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -862,6 +931,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "DHCP Options",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -883,6 +954,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "EBS Snapshot",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -904,6 +977,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "EBS Snapshot Copy",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -913,6 +988,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "EBS Snapshot Import",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -922,6 +999,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "EBS Volume",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -937,6 +1016,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Capacity Reservation",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -946,6 +1027,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Carrier Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -961,6 +1044,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Client VPN Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -982,6 +1067,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Fleet",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -991,6 +1078,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Host",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1021,6 +1110,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Local Gateway Route Table VPC Association",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1030,6 +1121,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Managed Prefix List",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1045,6 +1138,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Network Insights Analysis",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1054,6 +1149,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Network Insights Path",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1087,6 +1184,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Traffic Mirror Filter",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1102,6 +1201,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Traffic Mirror Session",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1111,6 +1212,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Traffic Mirror Target",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1120,6 +1223,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1129,6 +1234,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Connect",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1138,6 +1245,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Connect Peer",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1147,6 +1256,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Multicast Domain",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1174,6 +1285,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Peering Attachment",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1183,6 +1296,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Peering Attachment Accepter",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1192,6 +1307,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Policy Table",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1219,6 +1336,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway Route Table",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1240,6 +1359,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway VPC Attachment",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1249,6 +1370,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Transit Gateway VPC Attachment Accepter",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1258,6 +1381,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Egress-Only Internet Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1267,6 +1392,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "EIP",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1282,6 +1409,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Flow Log",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1291,8 +1420,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Instance",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
 		},
 		{
 			Factory:  resourceInternetGateway,
@@ -1300,6 +1435,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Internet Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1315,6 +1452,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Key Pair",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: "key_pair_id",
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1324,6 +1463,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Launch Template",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1339,6 +1480,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "NAT Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1348,6 +1491,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Network ACL",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1369,6 +1514,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Network Interface",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1390,6 +1537,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Placement Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: "placement_group_id",
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1405,6 +1554,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Route Table",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1420,8 +1571,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Security Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
 		},
 		{
 			Factory:  resourceSecurityGroupRule,
@@ -1447,6 +1604,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Spot Fleet Request",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1456,6 +1615,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Spot Instance Request",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1465,6 +1626,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Subnet",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1474,6 +1637,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Verified Access Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1483,6 +1648,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Verified Access Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1492,6 +1659,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Verified Access Instance",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1513,6 +1682,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Verified Access Trust Provider",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1528,8 +1699,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPC",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrID),
 		},
 		{
 			Factory:  resourceVPCDHCPOptions,
@@ -1537,6 +1711,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "DHCP Options",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1552,6 +1728,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPC Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1591,6 +1769,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPC Endpoint Service",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1612,6 +1792,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "IPAM",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1627,6 +1809,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "IPAM Pool",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1654,6 +1838,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "IPAM Resource Discovery",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1663,6 +1849,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "IPAM Resource Discovery Association",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1672,6 +1860,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "IPAM Scope",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1699,6 +1889,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPC Peering Connection",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1708,6 +1900,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPC Peering Connection",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1723,6 +1917,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPN Connection",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -1738,6 +1934,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "VPN Gateway",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				MaxKeyLength:        127,
+				MaxValueLength:      255,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
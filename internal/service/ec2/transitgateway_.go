@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway", name="Transit Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGateway() *schema.Resource {
 	return &schema.Resource{
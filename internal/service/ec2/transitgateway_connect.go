@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_connect", name="Transit Gateway Connect")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayConnect() *schema.Resource {
 	return &schema.Resource{
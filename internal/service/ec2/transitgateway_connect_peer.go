@@ -30,7 +30,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_connect_peer", name="Transit Gateway Connect Peer")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayConnectPeer() *schema.Resource {
 	return &schema.Resource{
@@ -23,7 +23,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_multicast_domain", name="Transit Gateway Multicast Domain")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayMulticastDomain() *schema.Resource {
 	return &schema.Resource{
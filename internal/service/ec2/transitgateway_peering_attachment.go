@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_peering_attachment", name="Transit Gateway Peering Attachment")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayPeeringAttachment() *schema.Resource {
 	return &schema.Resource{
@@ -20,7 +20,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_peering_attachment_accepter", name="Transit Gateway Peering Attachment Accepter")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayPeeringAttachmentAccepter() *schema.Resource {
 	return &schema.Resource{
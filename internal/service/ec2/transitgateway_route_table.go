@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_route_table", name="Transit Gateway Route Table")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayRouteTable() *schema.Resource {
 	return &schema.Resource{
@@ -20,7 +20,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_vpc_attachment_accepter", name="Transit Gateway VPC Attachment Accepter")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTransitGatewayVPCAttachmentAccepter() *schema.Resource {
 	return &schema.Resource{
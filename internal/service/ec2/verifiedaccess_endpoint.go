@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_verifiedaccess_endpoint", name="Verified Access Endpoint")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVerifiedAccessEndpoint() *schema.Resource {
 	return &schema.Resource{
@@ -23,7 +23,7 @@ import (
 )
 
 // @SDKResource("aws_verifiedaccess_group", name="Verified Access Group")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVerifiedAccessGroup() *schema.Resource {
 	return &schema.Resource{
@@ -22,7 +22,7 @@ import (
 )
 
 // @SDKResource("aws_verifiedaccess_instance", name="Verified Access Instance")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVerifiedAccessInstance() *schema.Resource {
 	return &schema.Resource{
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_verifiedaccess_trust_provider", name="Verified Access Trust Provider")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVerifiedAccessTrustProvider() *schema.Resource {
 	return &schema.Resource{
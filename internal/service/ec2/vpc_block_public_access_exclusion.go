@@ -31,7 +31,7 @@ import (
 )
 
 // @FrameworkResource("aws_vpc_block_public_access_exclusion", name="VPC Block Public Access Exclusion")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=true)
 // @Testing(generator=false)
 // @Testing(name="BlockPublicAccessExclusion")
@@ -25,7 +25,7 @@ const (
 )
 
 // @SDKResource("aws_default_network_acl", name="Network ACL")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceDefaultNetworkACL() *schema.Resource {
 	return &schema.Resource{
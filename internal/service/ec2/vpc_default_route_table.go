@@ -22,7 +22,7 @@ import (
 )
 
 // @SDKResource("aws_default_route_table", name="Route Table")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceDefaultRouteTable() *schema.Resource {
 	return &schema.Resource{
@@ -17,7 +17,7 @@ import (
 )
 
 // @SDKResource("aws_default_security_group", name="Security Group")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceDefaultSecurityGroup() *schema.Resource {
 	//lintignore:R011
@@ -34,6 +34,10 @@ func resourceDefaultSecurityGroup() *schema.Resource {
 		SchemaVersion: 1, // Keep in sync with aws_security_group's schema version.
 		MigrateState:  securityGroupMigrateState,
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateSecurityGroupRuleConflicts,
+		},
+
 		// Keep in sync with aws_security_group's schema with the following changes:
 		//   - description is Computed-only
 		//   - name is Computed-only
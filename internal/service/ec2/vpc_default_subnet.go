@@ -23,7 +23,7 @@ import (
 )
 
 // @SDKResource("aws_default_subnet", name="Subnet")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceDefaultSubnet() *schema.Resource {
 	//lintignore:R011
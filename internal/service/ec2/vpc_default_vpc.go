@@ -21,7 +21,7 @@ import (
 )
 
 // @SDKResource("aws_default_vpc", name="Default VPC")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceDefaultVPC() *schema.Resource {
 	//lintignore:R011
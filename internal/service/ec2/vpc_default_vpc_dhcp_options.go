@@ -17,7 +17,7 @@ import (
 )
 
 // @SDKResource("aws_default_vpc_dhcp_options", name="DHCP Options")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceDefaultVPCDHCPOptions() *schema.Resource {
 	//lintignore:R011
@@ -23,7 +23,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_dhcp_options", name="DHCP Options")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPCDHCPOptions() *schema.Resource {
 	return &schema.Resource{
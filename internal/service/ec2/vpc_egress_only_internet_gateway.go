@@ -22,7 +22,7 @@ import (
 )
 
 // @SDKResource("aws_egress_only_internet_gateway", name="Egress-Only Internet Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceEgressOnlyInternetGateway() *schema.Resource {
 	return &schema.Resource{
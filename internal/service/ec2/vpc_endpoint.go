@@ -38,7 +38,7 @@ const (
 )
 
 // @SDKResource("aws_vpc_endpoint", name="VPC Endpoint")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPCEndpoint() *schema.Resource {
 	return &schema.Resource{
@@ -29,7 +29,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_endpoint_service", name="VPC Endpoint Service")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPCEndpointService() *schema.Resource {
 	return &schema.Resource{
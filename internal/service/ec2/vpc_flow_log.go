@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_flow_log", name="Flow Log")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceFlowLog() *schema.Resource {
 	return &schema.Resource{
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_internet_gateway", name="Internet Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceInternetGateway() *schema.Resource {
 	return &schema.Resource{
@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_ipam_pool", name="IPAM Pool")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceIPAMPool() *schema.Resource {
 	return &schema.Resource{
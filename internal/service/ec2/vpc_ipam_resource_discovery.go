@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_ipam_resource_discovery", name="IPAM Resource Discovery")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceIPAMResourceDiscovery() *schema.Resource {
 	return &schema.Resource{
@@ -23,7 +23,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_ipam_resource_discovery_association", name="IPAM Resource Discovery Association")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceIPAMResourceDiscoveryAssociation() *schema.Resource {
 	return &schema.Resource{
@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_ipam_scope", name="IPAM Scope")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceIPAMScope() *schema.Resource {
 	return &schema.Resource{
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_managed_prefix_list", name="Managed Prefix List")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceManagedPrefixList() *schema.Resource {
 	return &schema.Resource{
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_nat_gateway", name="NAT Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceNATGateway() *schema.Resource {
 	return &schema.Resource{
@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_network_acl", name="Network ACL")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceNetworkACL() *schema.Resource {
 	return &schema.Resource{
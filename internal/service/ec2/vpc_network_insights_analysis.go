@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_network_insights_analysis", name="Network Insights Analysis")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceNetworkInsightsAnalysis() *schema.Resource {
 	return &schema.Resource{
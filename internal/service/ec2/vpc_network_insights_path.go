@@ -84,7 +84,7 @@ func networkInsightsPathFilterSchema() map[string]*schema.Schema {
 }
 
 // @SDKResource("aws_ec2_network_insights_path", name="Network Insights Path")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceNetworkInsightsPath() *schema.Resource {
 	return &schema.Resource{
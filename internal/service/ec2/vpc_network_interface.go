@@ -33,7 +33,7 @@ import (
 )
 
 // @SDKResource("aws_network_interface", name="Network Interface")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceNetworkInterface() *schema.Resource {
 	return &schema.Resource{
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_peering_connection", name="VPC Peering Connection")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPCPeeringConnection() *schema.Resource {
 	return &schema.Resource{
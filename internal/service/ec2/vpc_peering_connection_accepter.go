@@ -17,7 +17,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_peering_connection_accepter", name="VPC Peering Connection")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPCPeeringConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
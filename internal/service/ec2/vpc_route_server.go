@@ -34,7 +34,7 @@ import (
 )
 
 // @FrameworkResource("aws_vpc_route_server", name="VPC Route Server")
-// @Tags(identifierAttribute="route_server_id")
+// @Tags(identifierAttribute="route_server_id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func newVPCRouteServerResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &vpcRouteServerResource{}
@@ -29,7 +29,7 @@ import (
 )
 
 // @FrameworkResource("aws_vpc_route_server_endpoint", name="VPC Route Server Endpoint")
-// @Tags(identifierAttribute="route_server_endpoint_id")
+// @Tags(identifierAttribute="route_server_endpoint_id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func newVPCRouteServerEndpointResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &vpcRouteServerEndpointResource{}
@@ -34,7 +34,7 @@ import (
 )
 
 // @FrameworkResource("aws_vpc_route_server_peer", name="VPC Route Server Peer")
-// @Tags(identifierAttribute="route_server_peer_id")
+// @Tags(identifierAttribute="route_server_peer_id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func newVPCRouteServerPeerResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &vpcRouteServerPeerResource{}
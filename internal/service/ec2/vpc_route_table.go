@@ -49,7 +49,7 @@ var routeTableValidTargets = []string{
 }
 
 // @SDKResource("aws_route_table", name="Route Table")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.RouteTable")
 // @Testing(generator=false)
 func resourceRouteTable() *schema.Resource {
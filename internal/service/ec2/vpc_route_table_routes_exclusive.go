@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route_table_routes_exclusive", name="Route Table Routes Exclusive")
+func newRouteTableRoutesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &routeTableRoutesExclusiveResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameRouteTableRoutesExclusive = "Route Table Routes Exclusive"
+)
+
+type routeTableRoutesExclusiveResource struct {
+	framework.ResourceWithModel[routeTableRoutesExclusiveResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *routeTableRoutesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"destinations": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ignore_route_targets": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(routeTableValidTargets...)),
+				},
+			},
+			"route_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *routeTableRoutesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan routeTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	routeTableID := plan.RouteTableID.ValueString()
+	if err := r.syncRoutes(ctx, plan, r.CreateTimeout(ctx, plan.Timeouts)); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameRouteTableRoutesExclusive, routeTableID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r *routeTableRoutesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state routeTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := state.RouteTableID.ValueString()
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameRouteTableRoutesExclusive, routeTableID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Routes added outside of Terraform show up as drift in the next plan.
+	// Configured routes that would otherwise be ignored are kept so that they do not cause a perpetual diff.
+	managed := routeTableExclusiveRoutes(routeTable.Routes, fwflex.ExpandFrameworkStringValueSet(ctx, state.IgnoreRouteTargets))
+	destinations := make([]string, 0, len(managed))
+	for destination := range managed {
+		destinations = append(destinations, destination)
+	}
+	for _, destination := range fwflex.ExpandFrameworkStringValueSet(ctx, state.Destinations) {
+		if _, ok := managed[destination]; ok {
+			continue
+		}
+		if slices.ContainsFunc(routeTable.Routes, func(v awstypes.Route) bool {
+			return routeDestination(v) == destination
+		}) {
+			destinations = append(destinations, destination)
+		}
+	}
+	state.Destinations = fwflex.FlattenFrameworkStringValueSetOfString(ctx, destinations)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *routeTableRoutesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state routeTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Destinations.Equal(state.Destinations) || !plan.IgnoreRouteTargets.Equal(state.IgnoreRouteTargets) {
+		routeTableID := plan.RouteTableID.ValueString()
+		if err := r.syncRoutes(ctx, plan, r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameRouteTableRoutesExclusive, routeTableID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *routeTableRoutesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("route_table_id"), request, response)
+}
+
+// syncRoutes deletes any routes in the route table that are not configured on this resource.
+//
+// Routes are created by other resources (e.g. aws_route), so configured
+// destinations that do not exist in the route table are reported as an error.
+func (r *routeTableRoutesExclusiveResource) syncRoutes(ctx context.Context, plan routeTableRoutesExclusiveResourceModel, timeout time.Duration) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := plan.RouteTableID.ValueString()
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+	if err != nil {
+		return err
+	}
+
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, plan.Destinations)
+	for _, destination := range want {
+		if !slices.ContainsFunc(routeTable.Routes, func(v awstypes.Route) bool {
+			return routeDestination(v) == destination
+		}) {
+			return fmt.Errorf("route with destination (%s) not found in Route Table (%s)", destination, routeTableID)
+		}
+	}
+
+	for destination, tfMap := range routeTableExclusiveRoutes(routeTable.Routes, fwflex.ExpandFrameworkStringValueSet(ctx, plan.IgnoreRouteTargets)) {
+		if slices.Contains(want, destination) {
+			continue
+		}
+
+		if err := routeTableDeleteRoute(ctx, conn, routeTableID, tfMap, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// routeTableExclusiveRoutes returns the routes subject to exclusive management, keyed by destination.
+// Local routes, propagated routes and routes managed by AWS services are never included,
+// nor are routes whose target attribute (e.g. "transit_gateway_id") is in ignoreTargets.
+func routeTableExclusiveRoutes(apiObjects []awstypes.Route, ignoreTargets []string) map[string]map[string]any {
+	routes := make(map[string]map[string]any)
+
+	for _, apiObject := range apiObjects {
+		switch aws.ToString(apiObject.GatewayId) {
+		case gatewayIDLocal, gatewayIDVPCLattice:
+			continue
+		}
+
+		if apiObject.Origin == awstypes.RouteOriginEnableVgwRoutePropagation {
+			continue
+		}
+
+		if apiObject.DestinationPrefixListId != nil && strings.HasPrefix(aws.ToString(apiObject.GatewayId), "vpce-") {
+			// VPC endpoint routes are handled by aws_vpc_endpoint.
+			continue
+		}
+
+		tfMap := flattenRoute(&apiObject)
+		if target, _ := routeTableRouteTargetAttribute(tfMap); slices.Contains(ignoreTargets, target) {
+			continue
+		}
+
+		if _, destination := routeTableRouteDestinationAttribute(tfMap); destination != "" {
+			routes[destination] = tfMap
+		}
+	}
+
+	return routes
+}
+
+// routeDestination returns the destination CIDR block or prefix list ID of the specified route.
+func routeDestination(apiObject awstypes.Route) string {
+	_, destination := routeTableRouteDestinationAttribute(flattenRoute(&apiObject))
+
+	return destination
+}
+
+type routeTableRoutesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	Destinations       fwtypes.SetOfString `tfsdk:"destinations"`
+	IgnoreRouteTargets fwtypes.SetOfString `tfsdk:"ignore_route_targets"`
+	RouteTableID       types.String        `tfsdk:"route_table_id"`
+	Timeouts           timeouts.Value      `tfsdk:"timeouts"`
+}
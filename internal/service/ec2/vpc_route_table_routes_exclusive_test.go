@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRouteTableExclusiveRoutes(t *testing.T) {
+	t.Parallel()
+
+	routes := []awstypes.Route{
+		{
+			DestinationCidrBlock: aws.String("10.1.0.0/16"),
+			GatewayId:            aws.String("local"),
+			Origin:               awstypes.RouteOriginCreateRouteTable,
+		},
+		{
+			DestinationCidrBlock: aws.String("0.0.0.0/0"),
+			GatewayId:            aws.String("igw-12345678"),
+			Origin:               awstypes.RouteOriginCreateRoute,
+		},
+		{
+			DestinationIpv6CidrBlock:    aws.String("::/0"),
+			EgressOnlyInternetGatewayId: aws.String("eigw-12345678"),
+			Origin:                      awstypes.RouteOriginCreateRoute,
+		},
+		{
+			DestinationCidrBlock: aws.String("192.168.0.0/16"),
+			GatewayId:            aws.String("vgw-12345678"),
+			Origin:               awstypes.RouteOriginEnableVgwRoutePropagation,
+		},
+		{
+			DestinationPrefixListId: aws.String("pl-12345678"),
+			GatewayId:               aws.String("vpce-12345678"),
+			Origin:                  awstypes.RouteOriginCreateRoute,
+		},
+		{
+			DestinationCidrBlock: aws.String("172.16.0.0/12"),
+			Origin:               awstypes.RouteOriginCreateRoute,
+			TransitGatewayId:     aws.String("tgw-12345678"),
+		},
+	}
+
+	testCases := map[string]struct {
+		ignoreTargets []string
+		expected      []string
+	}{
+		"no ignored targets": {
+			expected: []string{"0.0.0.0/0", "172.16.0.0/12", "::/0"},
+		},
+		"ignore transit gateway": {
+			ignoreTargets: []string{names.AttrTransitGatewayID},
+			expected:      []string{"0.0.0.0/0", "::/0"},
+		},
+		"ignore gateway and egress-only gateway": {
+			ignoreTargets: []string{"egress_only_gateway_id", "gateway_id"},
+			expected:      []string{"172.16.0.0/12"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for destination := range tfec2.RouteTableExclusiveRoutes(routes, testCase.ignoreTargets) {
+				got = append(got, destination)
+			}
+			slices.Sort(got)
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccVPCRouteTableRoutesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	rtResourceName := "aws_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", rtResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destinations.*", "0.0.0.0/0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "route_table_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "route_table_id",
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	rtResourceName := "aws_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableRoutesExclusiveAddRoute(ctx, &routeTable, "10.2.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					testAccCheckRouteTableRoutesExclusiveNoRoute(&routeTable, "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_ignoreRouteTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	rtResourceName := "aws_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ignoreRouteTargets(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_route_targets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ignore_route_targets.*", "gateway_id"),
+					testAccCheckRouteTableRoutesExclusiveAddRoute(ctx, &routeTable, "10.2.0.0/16"),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ignoreRouteTargets(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, rtResourceName, &routeTable),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRouteTableRoutesExclusiveAddRoute(ctx context.Context, v *awstypes.RouteTable, destinationCIDR string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		gatewayID := s.RootModule().Resources["aws_internet_gateway.test"].Primary.ID
+		input := ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destinationCIDR),
+			GatewayId:            aws.String(gatewayID),
+			RouteTableId:         v.RouteTableId,
+		}
+
+		_, err := conn.CreateRoute(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCheckRouteTableRoutesExclusiveNoRoute(v *awstypes.RouteTable, destinationCIDR string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, route := range v.Routes {
+			if aws.ToString(route.DestinationCidrBlock) == destinationCIDR {
+				return fmt.Errorf("route with destination (%s) still exists", destinationCIDR)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteConfig_ipv4InternetGateway(rName, "0.0.0.0/0"), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+  destinations   = [aws_route.test.destination_cidr_block]
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_ignoreRouteTargets(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteConfig_ipv4InternetGateway(rName, "0.0.0.0/0"), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id       = aws_route_table.test.id
+  destinations         = [aws_route.test.destination_cidr_block]
+  ignore_route_targets = ["gateway_id"]
+}
+`)
+}
@@ -35,9 +35,11 @@ import (
 )
 
 // @SDKResource("aws_security_group", name="Security Group")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
+// @IdentityAttribute("id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.SecurityGroup")
 // @Testing(importIgnore="revoke_rules_on_delete")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceSecurityGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -46,10 +48,6 @@ func resourceSecurityGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceSecurityGroupUpdate,
 		DeleteWithoutTimeout: resourceSecurityGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(15 * time.Minute),
@@ -58,6 +56,10 @@ func resourceSecurityGroup() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  securityGroupMigrateState,
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateSecurityGroupRuleConflicts,
+		},
+
 		// Keep in sync with aws_default_security_group's schema.
 		// See notes in vpc_default_security_group.go.
 		Schema: map[string]*schema.Schema{
@@ -14,7 +14,7 @@ import (
 )
 
 // @FrameworkResource("aws_vpc_security_group_egress_rule", name="Security Group Egress Rule")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.SecurityGroupRule")
 func newSecurityGroupEgressRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &securityGroupEgressRuleResource{}
@@ -66,3 +66,7 @@ func (r *securityGroupEgressRuleResource) findByID(ctx context.Context, id strin
 
 	return findSecurityGroupEgressRuleByID(ctx, conn, id)
 }
+
+func (*securityGroupEgressRuleResource) isEgress() bool {
+	return true
+}
@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -41,8 +42,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Set to any value to check planned aws_vpc_security_group_ingress_rule and aws_vpc_security_group_egress_rule
+	// resources for conflicts with the existing rules of their security group. The check reads the security group's rules.
+	securityGroupRuleConflictsEnvVar = "TF_AWS_CHECK_SECURITY_GROUP_RULE_CONFLICTS"
+)
+
 // @FrameworkResource("aws_vpc_security_group_ingress_rule", name="Security Group Ingress Rule")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.SecurityGroupRule")
 func newSecurityGroupIngressRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &securityGroupIngressRuleResource{}
@@ -100,6 +107,10 @@ func (r *securityGroupIngressRuleResource) findByID(ctx context.Context, id stri
 	return findSecurityGroupIngressRuleByID(ctx, conn, id)
 }
 
+func (*securityGroupIngressRuleResource) isEgress() bool {
+	return false
+}
+
 // moveStateResourceSecurityGroupRule transforms the state of an `aws_security_group_rule` resource to this resource's schema.
 func (r *securityGroupIngressRuleResource) moveStateResourceSecurityGroupRule(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
 	if request.SourceTypeName != "aws_security_group_rule" {
@@ -163,6 +174,7 @@ type securityGroupRule interface {
 	create(context.Context, *securityGroupRuleResourceModel) (string, error)
 	delete(context.Context, *securityGroupRuleResourceModel) error
 	findByID(context.Context, string) (*awstypes.SecurityGroupRule, error)
+	isEgress() bool
 }
 
 type securityGroupRuleResource struct {
@@ -379,22 +391,90 @@ func (r *securityGroupRuleResource) Delete(ctx context.Context, request resource
 }
 
 func (r *securityGroupRuleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
-		var old, new securityGroupRuleResourceModel
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var new securityGroupRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var excludeRuleID string
+	if !request.State.Raw.IsNull() {
+		var old securityGroupRuleResourceModel
 		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
 		if response.Diagnostics.HasError() {
 			return
 		}
-		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
-		if response.Diagnostics.HasError() {
-			return
-		}
 
 		// When you modify a rule, you cannot change the rule's source type.
 		if new, old := new.sourceAttributeName(), old.sourceAttributeName(); new != old {
 			response.RequiresReplace = []path.Path{path.Root(old), path.Root(new)}
 		}
+
+		// Only check for conflicts when the traffic allowed by the rule changes.
+		oldRule, _ := old.securityGroupRuleSpec(r.isEgress())
+		if newRule, ok := new.securityGroupRuleSpec(r.isEgress()); ok && newRule == oldRule && new.SecurityGroupID.Equal(old.SecurityGroupID) {
+			return
+		}
+
+		excludeRuleID = old.SecurityGroupRuleID.ValueString()
+	}
+
+	if os.Getenv(securityGroupRuleConflictsEnvVar) == "" {
+		return
+	}
+
+	response.Diagnostics.Append(r.validateRuleConflicts(ctx, &new, excludeRuleID, request.State.Raw.IsNull())...)
+}
+
+// validateRuleConflicts reports a planned rule that duplicates another rule in the security group as an error
+// and a planned rule that overlaps another rule in the security group as a warning.
+// A rule being created is only warned about duplicates, as Terraform plans the replacement of a rule
+// (e.g. `-replace` or a tainted rule) as a create and the rule it replaces cannot be told apart from a duplicate.
+func (r *securityGroupRuleResource) validateRuleConflicts(ctx context.Context, data *securityGroupRuleResourceModel, excludeRuleID string, create bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule, ok := data.securityGroupRuleSpec(r.isEgress())
+	if !ok || data.SecurityGroupID.IsUnknown() || r.Meta() == nil {
+		return diags
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+	groupID := data.SecurityGroupID.ValueString()
+	output, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	// The check is advisory, so a failure to read the existing rules (e.g. missing ec2:DescribeSecurityGroupRules permission) must not block the plan.
+	if err != nil {
+		diags.AddWarning(fmt.Sprintf("reading VPC Security Group (%s) rules", groupID), fmt.Sprintf("Unable to check for duplicate or overlapping security group rules: %s", err))
+
+		return diags
+	}
+
+	accountID := r.Meta().AccountID(ctx)
+	for _, apiObject := range output {
+		id := aws.ToString(apiObject.SecurityGroupRuleId)
+		if id == excludeRuleID {
+			continue
+		}
+
+		other := securityGroupRuleSpecFromAPIObject(ctx, apiObject, accountID)
+		switch {
+		case rule.duplicates(other) && create:
+			diags.AddAttributeWarning(path.Root(data.sourceAttributeName()), "Duplicate security group rule",
+				fmt.Sprintf("The %s already exists in VPC Security Group (%s) as rule %s. Unless this rule replaces it, AWS rejects the rule with an InvalidPermission.Duplicate error.", rule, groupID, id))
+		case rule.duplicates(other):
+			diags.AddAttributeError(path.Root(data.sourceAttributeName()), "Duplicate security group rule",
+				fmt.Sprintf("The %s already exists in VPC Security Group (%s) as rule %s. AWS rejects duplicate rules with an InvalidPermission.Duplicate error.", rule, groupID, id))
+		case rule.overlaps(other):
+			diags.AddAttributeWarning(path.Root(data.sourceAttributeName()), "Overlapping security group rules",
+				fmt.Sprintf("The %s overlaps the %s (%s) in VPC Security Group (%s). Consider merging or removing the redundant rule.", rule, other, id, groupID))
+		}
 	}
+
+	return diags
 }
 
 func (r *securityGroupRuleResource) ConfigValidators(context.Context) []resource.ConfigValidator {
@@ -513,6 +593,72 @@ func (model *securityGroupRuleResourceModel) expandSecurityGroupRuleRequest(ctx
 	return apiObject
 }
 
+// securityGroupRuleSpec returns the rule in the form used to detect conflicting rules.
+// It returns false if the protocol, ports or source are not yet known.
+func (model *securityGroupRuleResourceModel) securityGroupRuleSpec(egress bool) (securityGroupRuleSpec, bool) {
+	if model.IPProtocol.IsUnknown() || model.FromPort.IsUnknown() || model.ToPort.IsUnknown() {
+		return securityGroupRuleSpec{}, false
+	}
+
+	rule := securityGroupRuleSpec{
+		egress:     egress,
+		protocol:   model.IPProtocol.ValueString(),
+		fromPort:   -1,
+		toPort:     -1,
+		sourceType: model.sourceAttributeName(),
+	}
+
+	if !model.FromPort.IsNull() {
+		rule.fromPort = model.FromPort.ValueInt64()
+	}
+	if !model.ToPort.IsNull() {
+		rule.toPort = model.ToPort.ValueInt64()
+	}
+
+	var source types.String
+	switch rule.sourceType {
+	case "cidr_ipv4":
+		source = model.CIDRIPv4
+	case "cidr_ipv6":
+		source = model.CIDRIPv6
+	case "prefix_list_id":
+		source = model.PrefixListID
+	case "referenced_security_group_id":
+		source = model.ReferencedSecurityGroupID
+	default:
+		return securityGroupRuleSpec{}, false
+	}
+
+	if source.IsUnknown() {
+		return securityGroupRuleSpec{}, false
+	}
+	rule.source = source.ValueString()
+
+	return rule, true
+}
+
+func securityGroupRuleSpecFromAPIObject(ctx context.Context, apiObject awstypes.SecurityGroupRule, accountID string) securityGroupRuleSpec {
+	rule := securityGroupRuleSpec{
+		egress:   aws.ToBool(apiObject.IsEgress),
+		protocol: aws.ToString(apiObject.IpProtocol),
+		fromPort: int64(aws.ToInt32(apiObject.FromPort)),
+		toPort:   int64(aws.ToInt32(apiObject.ToPort)),
+	}
+
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		rule.sourceType, rule.source = "cidr_ipv4", aws.ToString(apiObject.CidrIpv4)
+	case apiObject.CidrIpv6 != nil:
+		rule.sourceType, rule.source = "cidr_ipv6", aws.ToString(apiObject.CidrIpv6)
+	case apiObject.PrefixListId != nil:
+		rule.sourceType, rule.source = "prefix_list_id", aws.ToString(apiObject.PrefixListId)
+	case apiObject.ReferencedGroupInfo != nil:
+		rule.sourceType, rule.source = "referenced_security_group_id", flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID).ValueString()
+	}
+
+	return rule
+}
+
 func (model *securityGroupRuleResourceModel) sourceAttributeName() string {
 	switch {
 	case !model.CIDRIPv4.IsNull():
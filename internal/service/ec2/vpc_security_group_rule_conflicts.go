@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// securityGroupRuleSpec is a single security group rule (one protocol, port range and source)
+// in the form used to detect redundant and overlapping rules at plan time.
+type securityGroupRuleSpec struct {
+	egress     bool
+	protocol   string
+	fromPort   int64
+	toPort     int64
+	sourceType string // One of "cidr_ipv4", "cidr_ipv6", "prefix_list_id" or "referenced_security_group_id".
+	source     string
+}
+
+func (r securityGroupRuleSpec) String() string {
+	direction, preposition := "ingress", "from"
+	if r.egress {
+		direction, preposition = "egress", "to"
+	}
+
+	protocol := protocolForValue(r.protocol)
+	switch protocol {
+	case "-1":
+		return fmt.Sprintf("%s rule for all traffic %s %s", direction, preposition, r.source)
+	case "icmp", "icmpv6":
+		return fmt.Sprintf("%s rule for %s type %d code %d %s %s", direction, protocol, r.fromPort, r.toPort, preposition, r.source)
+	default:
+		return fmt.Sprintf("%s rule for %s ports %d-%d %s %s", direction, protocol, r.fromPort, r.toPort, preposition, r.source)
+	}
+}
+
+// duplicates returns whether the two rules are identical.
+// AWS rejects a duplicate rule with an InvalidPermission.Duplicate error.
+func (r securityGroupRuleSpec) duplicates(o securityGroupRuleSpec) bool {
+	if r.egress != o.egress || r.sourceType != o.sourceType {
+		return false
+	}
+
+	protocol := protocolForValue(r.protocol)
+	if protocol != protocolForValue(o.protocol) {
+		return false
+	}
+
+	if protocol != "-1" && (r.fromPort != o.fromPort || r.toPort != o.toPort) {
+		return false
+	}
+
+	return r.sourceEquals(o)
+}
+
+// overlaps returns whether the traffic allowed by the two rules overlaps,
+// e.g. 10.0.0.0/16 and 10.0.1.0/24 on intersecting port ranges.
+func (r securityGroupRuleSpec) overlaps(o securityGroupRuleSpec) bool {
+	if r.egress != o.egress || r.sourceType != o.sourceType {
+		return false
+	}
+
+	p1, p2 := protocolForValue(r.protocol), protocolForValue(o.protocol)
+	if p1 != p2 && p1 != "-1" && p2 != "-1" {
+		return false
+	}
+
+	if p1 == p2 && p1 != "-1" {
+		switch p1 {
+		case "icmp", "icmpv6":
+			// From and to ports are the ICMP type and code, -1 meaning all.
+			if !portWildcardEquals(r.fromPort, o.fromPort) || !portWildcardEquals(r.toPort, o.toPort) {
+				return false
+			}
+		default:
+			if !portRangesIntersect(r.fromPort, r.toPort, o.fromPort, o.toPort) {
+				return false
+			}
+		}
+	}
+
+	switch r.sourceType {
+	case "cidr_ipv4", "cidr_ipv6":
+		// Every CIDR block overlaps 0.0.0.0/0 and ::/0, so a rule open to all addresses is commonly
+		// combined with narrower rules on purpose and is not reported.
+		if isAllAddressesCIDRBlock(r.source) || isAllAddressesCIDRBlock(o.source) {
+			return false
+		}
+
+		return itypes.CIDRBlocksOverlap(r.source, o.source)
+	default:
+		return r.source == o.source
+	}
+}
+
+func (r securityGroupRuleSpec) sourceEquals(o securityGroupRuleSpec) bool {
+	switch r.sourceType {
+	case "cidr_ipv4", "cidr_ipv6":
+		return itypes.CIDRBlocksEqual(r.source, o.source)
+	default:
+		return r.source == o.source
+	}
+}
+
+// isAllAddressesCIDRBlock returns whether the CIDR block is 0.0.0.0/0 or ::/0.
+func isAllAddressesCIDRBlock(cidr string) bool {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+
+	ones, _ := ipnet.Mask.Size()
+
+	return ones == 0
+}
+
+func portWildcardEquals(v1, v2 int64) bool {
+	return v1 == v2 || v1 == -1 || v2 == -1
+}
+
+func portRangesIntersect(from1, to1, from2, to2 int64) bool {
+	if from1 == -1 || from2 == -1 {
+		return true
+	}
+
+	return from1 <= to2 && from2 <= to1
+}
+
+// securityGroupRuleConflict is a pair of conflicting rules, by index.
+type securityGroupRuleConflict struct {
+	i, j int
+}
+
+// findSecurityGroupRuleConflicts returns the pairs of rules that are exact duplicates
+// and the pairs of (non-duplicate) rules that overlap.
+func findSecurityGroupRuleConflicts(rules []securityGroupRuleSpec) ([]securityGroupRuleConflict, []securityGroupRuleConflict) {
+	var duplicates, overlaps []securityGroupRuleConflict
+
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			switch {
+			case rules[i].duplicates(rules[j]):
+				duplicates = append(duplicates, securityGroupRuleConflict{i: i, j: j})
+			case rules[i].overlaps(rules[j]):
+				overlaps = append(overlaps, securityGroupRuleConflict{i: i, j: j})
+			}
+		}
+	}
+
+	return duplicates, overlaps
+}
+
+func securityGroupRuleDuplicateDetail(r securityGroupRuleSpec) string {
+	return fmt.Sprintf("The %s is defined more than once. AWS rejects duplicate rules with an InvalidPermission.Duplicate error.", r)
+}
+
+func securityGroupRuleOverlapDetail(r1, r2 securityGroupRuleSpec) string {
+	return fmt.Sprintf("The %s overlaps the %s. Consider merging or removing the redundant rule.", r1, r2)
+}
+
+// validateSecurityGroupRuleConflicts reports duplicate rules as errors and overlapping rules as warnings
+// in the raw configuration of the ingress and egress rule sets of an aws_security_group or aws_default_security_group.
+// Rules with unknown values are skipped.
+func validateSecurityGroupRuleConflicts(_ context.Context, request schema.ValidateResourceConfigFuncRequest, response *schema.ValidateResourceConfigFuncResponse) {
+	config := request.RawConfig
+	if config.IsNull() || !config.IsKnown() {
+		return
+	}
+
+	var rules []securityGroupRuleSpec
+	for _, egress := range []bool{false, true} {
+		rules = append(rules, expandSecurityGroupRuleSpecsFromRawConfig(config.GetAttr(securityGroupRuleSetAttributeName(egress)), egress)...)
+	}
+
+	duplicates, overlaps := findSecurityGroupRuleConflicts(rules)
+	for _, v := range duplicates {
+		response.Diagnostics = append(response.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Duplicate security group rule",
+			Detail:        securityGroupRuleDuplicateDetail(rules[v.j]),
+			AttributePath: cty.GetAttrPath(securityGroupRuleSetAttributeName(rules[v.j].egress)),
+		})
+	}
+	for _, v := range overlaps {
+		response.Diagnostics = append(response.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Overlapping security group rules",
+			Detail:        securityGroupRuleOverlapDetail(rules[v.j], rules[v.i]),
+			AttributePath: cty.GetAttrPath(securityGroupRuleSetAttributeName(rules[v.j].egress)),
+		})
+	}
+}
+
+func securityGroupRuleSetAttributeName(egress bool) string {
+	if egress {
+		return "egress"
+	}
+
+	return "ingress"
+}
+
+// expandSecurityGroupRuleSpecsFromRawConfig expands the raw configuration of a security group rule set
+// into one securityGroupRuleSpec per rule source.
+func expandSecurityGroupRuleSpecsFromRawConfig(v cty.Value, egress bool) []securityGroupRuleSpec {
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var rules []securityGroupRuleSpec
+
+	for it := v.ElementIterator(); it.Next(); {
+		_, tfMap := it.Element()
+		if tfMap.IsNull() || !tfMap.IsKnown() {
+			continue
+		}
+
+		protocol, ok := rawConfigString(tfMap.GetAttr(names.AttrProtocol))
+		if !ok {
+			continue
+		}
+		fromPort, ok := rawConfigInt64(tfMap.GetAttr("from_port"))
+		if !ok {
+			continue
+		}
+		toPort, ok := rawConfigInt64(tfMap.GetAttr("to_port"))
+		if !ok {
+			continue
+		}
+
+		rule := securityGroupRuleSpec{
+			egress:   egress,
+			protocol: protocol,
+			fromPort: fromPort,
+			toPort:   toPort,
+		}
+
+		for _, v := range []struct {
+			attributeName, sourceType string
+		}{
+			{"cidr_blocks", "cidr_ipv4"},
+			{"ipv6_cidr_blocks", "cidr_ipv6"},
+			{"prefix_list_ids", "prefix_list_id"},
+			{names.AttrSecurityGroups, "referenced_security_group_id"},
+		} {
+			for _, source := range rawConfigStrings(tfMap.GetAttr(v.attributeName)) {
+				rule.sourceType, rule.source = v.sourceType, source
+				rules = append(rules, rule)
+			}
+		}
+
+		if v := tfMap.GetAttr("self"); !v.IsNull() && v.IsKnown() && v.True() {
+			rule.sourceType, rule.source = "referenced_security_group_id", "self"
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+func rawConfigString(v cty.Value) (string, bool) {
+	if v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+func rawConfigInt64(v cty.Value) (int64, bool) {
+	if v.IsNull() || !v.IsKnown() {
+		return 0, false
+	}
+
+	i, _ := v.AsBigFloat().Int64()
+
+	return i, true
+}
+
+func rawConfigStrings(v cty.Value) []string {
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var s []string
+
+	for it := v.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		if v, ok := rawConfigString(v); ok {
+			s = append(s, v)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestValidateSecurityGroupRuleConflicts(t *testing.T) {
+	t.Parallel()
+
+	ruleType := cty.Object(map[string]cty.Type{
+		"cidr_blocks":      cty.List(cty.String),
+		"from_port":        cty.Number,
+		"ipv6_cidr_blocks": cty.List(cty.String),
+		"prefix_list_ids":  cty.List(cty.String),
+		"protocol":         cty.String,
+		"security_groups":  cty.Set(cty.String),
+		"self":             cty.Bool,
+		"to_port":          cty.Number,
+	})
+	rule := func(protocol string, fromPort, toPort int64, cidrBlocks ...string) cty.Value {
+		v := map[string]cty.Value{
+			"cidr_blocks":      cty.NullVal(cty.List(cty.String)),
+			"from_port":        cty.NumberIntVal(fromPort),
+			"ipv6_cidr_blocks": cty.NullVal(cty.List(cty.String)),
+			"prefix_list_ids":  cty.NullVal(cty.List(cty.String)),
+			"protocol":         cty.StringVal(protocol),
+			"security_groups":  cty.NullVal(cty.Set(cty.String)),
+			"self":             cty.NullVal(cty.Bool),
+			"to_port":          cty.NumberIntVal(toPort),
+		}
+		if len(cidrBlocks) > 0 {
+			var vs []cty.Value
+			for _, cidrBlock := range cidrBlocks {
+				vs = append(vs, cty.StringVal(cidrBlock))
+			}
+			v["cidr_blocks"] = cty.ListVal(vs)
+		}
+		return cty.ObjectVal(v)
+	}
+	ruleIPv6 := func(protocol string, fromPort, toPort int64, ipv6CIDRBlock string) cty.Value {
+		v := rule(protocol, fromPort, toPort).AsValueMap()
+		v["ipv6_cidr_blocks"] = cty.ListVal([]cty.Value{cty.StringVal(ipv6CIDRBlock)})
+		return cty.ObjectVal(v)
+	}
+	config := func(ingress, egress []cty.Value) cty.Value {
+		v := map[string]cty.Value{
+			"egress":  cty.SetValEmpty(ruleType),
+			"ingress": cty.SetValEmpty(ruleType),
+		}
+		if len(ingress) > 0 {
+			v["ingress"] = cty.SetVal(ingress)
+		}
+		if len(egress) > 0 {
+			v["egress"] = cty.SetVal(egress)
+		}
+		return cty.ObjectVal(v)
+	}
+
+	testCases := map[string]struct {
+		config       cty.Value
+		wantErrors   int
+		wantWarnings int
+	}{
+		"no rules": {
+			config: config(nil, nil),
+		},
+		"distinct rules": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 80, 80, "10.0.0.0/16"),
+					rule("tcp", 443, 443, "10.0.0.0/16"),
+				},
+				[]cty.Value{
+					rule("-1", 0, 0, "0.0.0.0/0"),
+				},
+			),
+		},
+		"same rule ingress and egress": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 80, 80, "10.0.0.0/16"),
+				},
+				[]cty.Value{
+					rule("tcp", 80, 80, "10.0.0.0/16"),
+				},
+			),
+		},
+		"duplicate CIDR block across rules": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 80, 80, "10.0.0.0/16"),
+					rule("6", 80, 80, "10.0.0.0/16", "10.1.0.0/16"),
+				},
+				nil,
+			),
+			wantErrors: 1,
+		},
+		"overlapping CIDR blocks": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 80, 80, "10.0.0.0/16"),
+					rule("tcp", 80, 80, "10.0.1.0/24"),
+				},
+				nil,
+			),
+			wantWarnings: 1,
+		},
+		"overlapping port ranges": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 80, 90, "10.0.0.0/16"),
+					rule("tcp", 85, 100, "10.0.0.0/16"),
+				},
+				nil,
+			),
+			wantWarnings: 1,
+		},
+		"all protocols overlaps": {
+			config: config(
+				nil,
+				[]cty.Value{
+					rule("-1", 0, 0, "10.0.0.0/8"),
+					rule("tcp", 443, 443, "10.0.1.0/24"),
+				},
+			),
+			wantWarnings: 1,
+		},
+		"all addresses": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 22, 22, "10.0.0.0/16"),
+					rule("tcp", 22, 22, "0.0.0.0/0"),
+				},
+				[]cty.Value{
+					rule("-1", 0, 0, "0.0.0.0/0"),
+					rule("tcp", 443, 443, "10.0.0.0/8"),
+				},
+			),
+		},
+		"all IPv6 addresses": {
+			config: config(
+				[]cty.Value{
+					ruleIPv6("tcp", 443, 443, "2001:db8::/32"),
+					ruleIPv6("tcp", 443, 443, "::/0"),
+				},
+				nil,
+			),
+		},
+		"overlapping IPv6 CIDR blocks": {
+			config: config(
+				[]cty.Value{
+					ruleIPv6("tcp", 443, 443, "2001:db8::/32"),
+					ruleIPv6("tcp", 443, 443, "2001:db8:1::/48"),
+				},
+				nil,
+			),
+			wantWarnings: 1,
+		},
+		"duplicate all addresses": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 22, 22, "0.0.0.0/0"),
+					rule("6", 22, 22, "0.0.0.0/0"),
+				},
+				nil,
+			),
+			wantErrors: 1,
+		},
+		"different protocols": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 53, 53, "10.0.0.0/16"),
+					rule("udp", 53, 53, "10.0.0.0/16"),
+				},
+				nil,
+			),
+		},
+		"unknown CIDR block": {
+			config: config(
+				[]cty.Value{
+					rule("tcp", 80, 80, "10.0.0.0/16"),
+					cty.ObjectVal(map[string]cty.Value{
+						"cidr_blocks":      cty.UnknownVal(cty.List(cty.String)),
+						"from_port":        cty.NumberIntVal(80),
+						"ipv6_cidr_blocks": cty.NullVal(cty.List(cty.String)),
+						"prefix_list_ids":  cty.NullVal(cty.List(cty.String)),
+						"protocol":         cty.StringVal("tcp"),
+						"security_groups":  cty.NullVal(cty.Set(cty.String)),
+						"self":             cty.NullVal(cty.Bool),
+						"to_port":          cty.NumberIntVal(80),
+					}),
+				},
+				nil,
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := schema.ValidateResourceConfigFuncRequest{RawConfig: testCase.config}
+			var response schema.ValidateResourceConfigFuncResponse
+			tfec2.ValidateSecurityGroupRuleConflicts(context.Background(), request, &response)
+
+			var gotErrors, gotWarnings int
+			for _, d := range response.Diagnostics {
+				switch d.Severity {
+				case diag.Error:
+					gotErrors++
+				case diag.Warning:
+					gotWarnings++
+				}
+			}
+
+			if gotErrors != testCase.wantErrors {
+				t.Errorf("errors = %d, want %d: %v", gotErrors, testCase.wantErrors, response.Diagnostics)
+			}
+			if gotWarnings != testCase.wantWarnings {
+				t.Errorf("warnings = %d, want %d: %v", gotWarnings, testCase.wantWarnings, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithModel[securityGroupRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := plan.SecurityGroupID.ValueString()
+	if err := r.syncRules(ctx, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, plan.SecurityGroupRuleIDs)); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := state.SecurityGroupID.ValueString()
+	_, err := findSecurityGroupByID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Rules added outside of Terraform show up as drift in the next plan.
+	state.SecurityGroupRuleIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, tfslices.ApplyToAll(rules, func(v awstypes.SecurityGroupRule) string {
+		return aws.ToString(v.SecurityGroupRuleId)
+	}))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SecurityGroupRuleIDs.Equal(state.SecurityGroupRuleIDs) {
+		groupID := plan.SecurityGroupID.ValueString()
+		if err := r.syncRules(ctx, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, plan.SecurityGroupRuleIDs)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, groupID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncRules revokes any rules in the security group that are not configured on this resource.
+//
+// Rule IDs are assigned by AWS, so configured rules that do not exist in the
+// security group cannot be created here and are reported as an error.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, groupID string, want []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	have, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return err
+	}
+
+	var ingressRuleIDs, egressRuleIDs []string
+	found := make(map[string]bool, len(want))
+	for _, id := range want {
+		found[id] = false
+	}
+	for _, rule := range have {
+		id := aws.ToString(rule.SecurityGroupRuleId)
+		if _, ok := found[id]; ok {
+			found[id] = true
+			continue
+		}
+
+		if aws.ToBool(rule.IsEgress) {
+			egressRuleIDs = append(egressRuleIDs, id)
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, id)
+		}
+	}
+
+	for _, id := range want {
+		if !found[id] {
+			return fmt.Errorf("security group rule (%s) not found in security group (%s)", id, groupID)
+		}
+	}
+
+	if len(ingressRuleIDs) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: ingressRuleIDs,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, &input); err != nil {
+			return fmt.Errorf("revoking ingress rules %v: %w", ingressRuleIDs, err)
+		}
+	}
+
+	if len(egressRuleIDs) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: egressRuleIDs,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, &input); err != nil {
+			return fmt.Errorf("revoking egress rules %v: %w", egressRuleIDs, err)
+		}
+	}
+
+	return nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	SecurityGroupID      types.String        `tfsdk:"security_group_id"`
+	SecurityGroupRuleIDs fwtypes.SetOfString `tfsdk:"security_group_rule_ids"`
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, sgResourceName, &group),
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", sgResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_rule_ids.*", "aws_vpc_security_group_ingress_rule.test", "security_group_rule_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_rule_ids.*", "aws_vpc_security_group_egress_rule.test", "security_group_rule_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "security_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "security_group_id",
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, sgResourceName, &group),
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, sgResourceName, &group),
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					testAccCheckSecurityGroupRulesExclusiveAddIngressRule(ctx, &group),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, sgResourceName, &group),
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, n, errors.New("not found"))
+		}
+
+		groupID := rs.Primary.Attributes["security_group_id"]
+		if groupID == "" {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+		if err != nil {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, err)
+		}
+
+		if got, want := rs.Primary.Attributes["security_group_rule_ids.#"], strconv.Itoa(len(output)); got != want {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, errors.New("unexpected security_group_rule_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveAddIngressRule(ctx context.Context, v *awstypes.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: v.GroupId,
+			IpPermissions: []awstypes.IpPermission{{
+				FromPort:   aws.Int32(22),
+				IpProtocol: aws.String("tcp"),
+				IpRanges: []awstypes.IpRange{{
+					CidrIp: aws.String("10.1.0.0/16"),
+				}},
+				ToPort: aws.Int32(22),
+			}},
+		}
+
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+  security_group_rule_ids = [
+    aws_vpc_security_group_ingress_rule.test.security_group_rule_id,
+    aws_vpc_security_group_egress_rule.test.security_group_rule_id,
+  ]
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id       = aws_security_group.test.id
+  security_group_rule_ids = []
+}
+`)
+}
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_subnet", name="Subnet")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.Subnet")
 // @Testing(generator=false)
 func resourceSubnet() *schema.Resource {
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_traffic_mirror_filter", name="Traffic Mirror Filter")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTrafficMirrorFilter() *schema.Resource {
 	return &schema.Resource{
@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_traffic_mirror_session", name="Traffic Mirror Session")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTrafficMirrorSession() *schema.Resource {
 	return &schema.Resource{
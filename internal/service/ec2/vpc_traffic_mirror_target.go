@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_traffic_mirror_target", name="Traffic Mirror Target")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceTrafficMirrorTarget() *schema.Resource {
 	return &schema.Resource{
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_client_vpn_endpoint", name="Client VPN Endpoint")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceClientVPNEndpoint() *schema.Resource {
 	return &schema.Resource{
@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_vpn_connection", name="VPN Connection")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPNConnection() *schema.Resource {
 	return &schema.Resource{
@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_customer_gateway", name="Customer Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceCustomerGateway() *schema.Resource {
 	return &schema.Resource{
@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_vpn_gateway", name="VPN Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceVPNGateway() *schema.Resource {
 	return &schema.Resource{
@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_carrier_gateway, name="Carrier Gateway")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", maxKeyLength=127, maxValueLength=255)
 // @Testing(tagsTest=false)
 func resourceCarrierGateway() *schema.Resource {
 	return &schema.Resource{
@@ -5,6 +5,8 @@ package ecr
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -58,7 +60,11 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRClient(ctx)
 
-	out, err := conn.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	var input ecr.GetAuthorizationTokenInput
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryIds = []string{v.(string)} //nolint:staticcheck // deprecated by AWS, but still selects the registry's proxy endpoint
+	}
+	out, err := conn.GetAuthorizationToken(ctx, &input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECR Authorization Token: %s", err)
@@ -68,17 +74,10 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
 	expiresAt := aws.ToTime(authorizationData.ExpiresAt).Format(time.RFC3339)
 	proxyEndpoint := aws.ToString(authorizationData.ProxyEndpoint)
-	authBytes, err := itypes.Base64Decode(authorizationToken)
+	userName, password, err := decodeAuthorizationToken(authorizationToken)
 	if err != nil {
-		d.SetId("")
-		return sdkdiag.AppendErrorf(diags, "decoding ECR authorization token: %s", err)
-	}
-	basicAuthorization := strings.Split(string(authBytes), ":")
-	if len(basicAuthorization) != 2 {
-		return sdkdiag.AppendErrorf(diags, "unknown ECR authorization token format")
+		return sdkdiag.AppendFromErr(diags, err)
 	}
-	userName := basicAuthorization[0]
-	password := basicAuthorization[1]
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("authorization_token", authorizationToken)
 	d.Set("proxy_endpoint", proxyEndpoint)
@@ -88,3 +87,18 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 
 	return diags
 }
+
+// decodeAuthorizationToken decodes a base64-encoded ECR authorization token into its user name and password.
+func decodeAuthorizationToken(authorizationToken string) (string, string, error) {
+	authBytes, err := itypes.Base64Decode(authorizationToken)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding ECR authorization token: %w", err)
+	}
+
+	basicAuthorization := strings.Split(string(authBytes), ":")
+	if len(basicAuthorization) != 2 {
+		return "", "", errors.New("unknown ECR authorization token format")
+	}
+
+	return basicAuthorization[0], basicAuthorization[1], nil
+}
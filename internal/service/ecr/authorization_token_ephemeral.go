@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ecr_authorization_token", name="Authorization Token")
+func newAuthorizationTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authorizationTokenEphemeralResource{}, nil
+}
+
+type authorizationTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authorizationTokenEphemeralResourceModel]
+}
+
+func (e *authorizationTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Temporary IAM authentication credentials, base64 encoded in the form user_name:password.",
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "Time when the authorization token expires.",
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password decoded from the authorization token.",
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Registry URL to use in the docker login command.",
+			},
+			"registry_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "AWS account ID of the ECR registry. Defaults to the account of the provider's credentials.",
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed:    true,
+				Description: "User name decoded from the authorization token.",
+			},
+		},
+	}
+}
+
+func (e *authorizationTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authorizationTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().ECRClient(ctx)
+
+	var input ecr.GetAuthorizationTokenInput
+	if v := data.RegistryID.ValueString(); v != "" {
+		input.RegistryIds = []string{v} //nolint:staticcheck // deprecated by AWS, but still selects the registry's proxy endpoint
+	}
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+
+	if err == nil && len(output.AuthorizationData) == 0 {
+		err = tfresource.NewEmptyResultError(&input)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading ECR Authorization Token", err.Error())
+
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+	userName, password, err := decodeAuthorizationToken(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError("reading ECR Authorization Token", err.Error())
+
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = timetypes.NewRFC3339TimePointerValue(authorizationData.ExpiresAt)
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = fwflex.StringToFramework(ctx, authorizationData.ProxyEndpoint)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type authorizationTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	RegistryID         types.String      `tfsdk:"registry_id"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDecodeAuthorizationToken(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		token            string
+		expectedUserName string
+		expectedPassword string
+		expectError      bool
+	}{
+		"valid": {
+			token:            itypes.Base64Encode([]byte("AWS:secret")),
+			expectedUserName: "AWS",
+			expectedPassword: "secret",
+		},
+		"not base64": {
+			token:       "not-base64!",
+			expectError: true,
+		},
+		"no separator": {
+			token:       itypes.Base64Encode([]byte("AWS")),
+			expectError: true,
+		},
+		"too many separators": {
+			token:       itypes.Base64Encode([]byte("AWS:secret:extra")),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			userName, password, err := tfecr.DecodeAuthorizationToken(testCase.token)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := userName, testCase.expectedUserName; got != want {
+				t.Errorf("user name = %q, want %q", got, want)
+			}
+			if got, want := password, testCase.expectedPassword; got != want {
+				t.Errorf("password = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECRAuthorizationTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.ECRServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationTokenEphemeralResourceConfig_basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("authorization_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrPassword), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("proxy_endpoint"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrUserName), knownvalue.StringRegexp(regexache.MustCompile(`AWS`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccECRAuthorizationTokenEphemeral_registryID(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.ECRServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationTokenEphemeralResourceConfig_registryID(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("authorization_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("proxy_endpoint"), knownvalue.StringRegexp(regexache.MustCompile(`^https://`+acctest.AccountID(ctx)+`\.dkr\.ecr\.`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("registry_id"), knownvalue.StringExact(acctest.AccountID(ctx))),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthorizationTokenEphemeralResourceConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_ecr_authorization_token.test"),
+		`
+ephemeral "aws_ecr_authorization_token" "test" {}
+`)
+}
+
+func testAccAuthorizationTokenEphemeralResourceConfig_registryID() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_ecr_authorization_token.test"),
+		`
+data "aws_caller_identity" "current" {}
+
+ephemeral "aws_ecr_authorization_token" "test" {
+  registry_id = data.aws_caller_identity.current.account_id
+}
+`)
+}
@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
 		Name: "aws_efs_access_point",
 		F:    sweepAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    sweepFileSystems,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
 		Name: "aws_efs_mount_target",
 		F:    sweepMountTargets,
 	})
@@ -28,8 +28,10 @@ import (
 
 // @SDKResource("aws_lb_trust_store", name="Trust Store")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types;types.TrustStore")
 // @Testing(importIgnore="ca_certificates_bundle_s3_bucket;ca_certificates_bundle_s3_key")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceTrustStore() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrustStoreCreate,
@@ -37,10 +39,6 @@ func resourceTrustStore() *schema.Resource {
 		UpdateWithoutTimeout: resourceTrustStoreUpdate,
 		DeleteWithoutTimeout: resourceTrustStoreDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Delete: schema.DefaultTimeout(2 * time.Minute),
@@ -4,6 +4,7 @@
 package emr
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -17,12 +18,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -56,21 +57,22 @@ func sweepClusters(region string) error {
 
 		for _, v := range page.Clusters {
 			id := aws.ToString(v.Id)
-
-			_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
-				JobFlowIds:           []string{id},
-				TerminationProtected: aws.Bool(false),
-			})
-
-			if err != nil {
-				log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
-			}
-
 			r := resourceCluster()
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.WithPreDelete(sweep.NewSweepResource(r, d, client), func(ctx context.Context) error {
+				_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
+					JobFlowIds:           []string{id},
+					TerminationProtected: aws.Bool(false),
+				})
+
+				if err != nil {
+					log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				}
+
+				return nil
+			}))
 		}
 	}
 
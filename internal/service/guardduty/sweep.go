@@ -16,13 +16,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -30,6 +30,10 @@ func RegisterSweepers() {
 
 func sweepDetectors(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping GuardDuty Detector sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -78,6 +82,10 @@ func sweepDetectors(region string) error {
 
 func sweepPublishingDestinations(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -26,6 +26,12 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy", name="Group Policy")
+// @IdentityAttribute("group")
+// @IdentityAttribute("name")
+// @IdAttrFormat("{group}:{name}")
+// @ImportIDHandler("groupPolicyImportID")
+// @Testing(existsType="string")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyPut,
@@ -33,10 +39,6 @@ func resourceGroupPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceGroupPolicyPut,
 		DeleteWithoutTimeout: resourceGroupPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"group": {
 				Type:     schema.TypeString,
@@ -96,7 +98,7 @@ func resourceGroupPolicyPut(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	if d.IsNewResource() {
-		d.SetId(fmt.Sprintf("%s:%s", groupName, policyName))
+		d.SetId(createGroupPolicyImportID(groupName, policyName))
 
 		_, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
 			return FindGroupPolicyByTwoPartKey(ctx, conn, groupName, policyName)
@@ -201,6 +203,10 @@ func FindGroupPolicyByTwoPartKey(ctx context.Context, conn *iam.Client, groupNam
 	return aws.ToString(output.PolicyDocument), nil
 }
 
+func createGroupPolicyImportID(groupName, policyName string) string {
+	return fmt.Sprintf("%s:%s", groupName, policyName)
+}
+
 func GroupPolicyParseID(id string) (groupName, policyName string, err error) {
 	parts := strings.SplitN(id, ":", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -212,3 +218,22 @@ func GroupPolicyParseID(id string) (groupName, policyName string, err error) {
 	policyName = parts[1]
 	return
 }
+
+type groupPolicyImportID struct{}
+
+func (groupPolicyImportID) Create(d *schema.ResourceData) string {
+	return createGroupPolicyImportID(d.Get("group").(string), d.Get(names.AttrName).(string))
+}
+
+func (groupPolicyImportID) Parse(id string) (string, map[string]string, error) {
+	groupName, policyName, err := GroupPolicyParseID(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		"group":        groupName,
+		names.AttrName: policyName,
+	}
+	return id, result, nil
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -26,16 +25,17 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy_attachment", name="Group Policy Attachment")
+// @IdentityAttribute("group")
+// @IdentityAttribute("policy_arn")
+// @IdAttrFormat("{group}/{policy_arn}")
+// @ImportIDHandler("groupPolicyAttachmentImportID")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceGroupPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceGroupPolicyAttachmentRead,
 		DeleteWithoutTimeout: resourceGroupPolicyAttachmentDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceGroupPolicyAttachmentImport,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"group": {
 				Type:     schema.TypeString,
@@ -63,8 +63,7 @@ func resourceGroupPolicyAttachmentCreate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
-	d.SetId(id.PrefixedUniqueId(fmt.Sprintf("%s-", group)))
+	d.SetId(createGroupPolicyAttachmentImportID(d))
 
 	return append(diags, resourceGroupPolicyAttachmentRead(ctx, d, meta)...)
 }
@@ -106,22 +105,6 @@ func resourceGroupPolicyAttachmentDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
-func resourceGroupPolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <group-name>/<policy_arn>", d.Id())
-	}
-
-	groupName := idParts[0]
-	policyARN := idParts[1]
-
-	d.Set("group", groupName)
-	d.Set("policy_arn", policyARN)
-	d.SetId(fmt.Sprintf("%s-%s", groupName, policyARN))
-
-	return []*schema.ResourceData{d}, nil
-}
-
 func attachPolicyToGroup(ctx context.Context, conn *iam.Client, group, policyARN string) error {
 	var errConcurrentModificationException *awstypes.ConcurrentModificationException
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (any, error) {
@@ -205,3 +188,26 @@ func findAttachedGroupPolicies(ctx context.Context, conn *iam.Client, input *iam
 
 	return output, nil
 }
+
+func createGroupPolicyAttachmentImportID(d *schema.ResourceData) string {
+	return (groupPolicyAttachmentImportID{}).Create(d)
+}
+
+type groupPolicyAttachmentImportID struct{}
+
+func (groupPolicyAttachmentImportID) Create(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/%s", d.Get("group").(string), d.Get("policy_arn").(string))
+}
+
+func (groupPolicyAttachmentImportID) Parse(id string) (string, map[string]string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("unexpected format for Import ID (%q), expected <group-name>/<policy_arn>", id)
+	}
+
+	result := map[string]string{
+		"group":      parts[0],
+		"policy_arn": parts[1],
+	}
+	return id, result, nil
+}
@@ -33,7 +33,7 @@ const (
 )
 
 // @SDKResource("aws_iam_instance_profile", name="Instance Profile")
-// @Tags(identifierAttribute="id", resourceType="InstanceProfile")
+// @Tags(identifierAttribute="id", resourceType="InstanceProfile", restrictedCharacters=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.InstanceProfile")
 func resourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
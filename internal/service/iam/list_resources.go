@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_iam_role", name="Role")
+func listRoles(ctx context.Context, meta any) iter.Seq2[inttypes.ListResult, error] {
+	return func(yield func(inttypes.ListResult, error) bool) {
+		conn := meta.(*conns.AWSClient).IAMClient(ctx)
+		input := iam.ListRolesInput{}
+
+		pages := iam.NewListRolesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(inttypes.ListResult{}, fmt.Errorf("listing IAM Roles: %w", err))
+				return
+			}
+
+			for _, v := range page.Roles {
+				name := aws.ToString(v.RoleName)
+				result := inttypes.ListResult{
+					ID:          name,
+					DisplayName: name,
+					Identity: map[string]string{
+						names.AttrName: name,
+					},
+				}
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
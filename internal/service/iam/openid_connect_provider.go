@@ -24,8 +24,10 @@ import (
 )
 
 // @SDKResource("aws_iam_openid_connect_provider", name="OIDC Provider")
-// @Tags(identifierAttribute="arn", resourceType="OIDCProvider")
+// @Tags(identifierAttribute="arn", resourceType="OIDCProvider", restrictedCharacters=true)
+// @ArnIdentity
 // @Testing(name="OpenIDConnectProvider")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceOpenIDConnectProvider() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOpenIDConnectProviderCreate,
@@ -33,10 +35,6 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 		UpdateWithoutTimeout: resourceOpenIDConnectProviderUpdate,
 		DeleteWithoutTimeout: resourceOpenIDConnectProviderDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
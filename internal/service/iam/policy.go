@@ -35,8 +35,10 @@ const (
 )
 
 // @SDKResource("aws_iam_policy", name="Policy")
-// @Tags(identifierAttribute="arn", resourceType="Policy")
+// @Tags(identifierAttribute="arn", resourceType="Policy", restrictedCharacters=true)
+// @ArnIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
+// @Testing(preIdentityVersion="6.4.0")
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyCreate,
@@ -44,10 +46,6 @@ func resourcePolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourcePolicyUpdate,
 		DeleteWithoutTimeout: resourcePolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -169,144 +170,54 @@ func dataSourcePolicyDocument() *schema.Resource {
 
 func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	mergedDoc := &IAMPolicyDoc{}
 
-	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]any)) > 0 {
-		// generate sid map to assure there are no duplicates in source jsons
-		sidMap := make(map[string]struct{})
-		for _, stmt := range mergedDoc.Statements {
-			if stmt.Sid != "" {
-				sidMap[stmt.Sid] = struct{}{}
-			}
-		}
-
-		// merge sourceDocs in order specified
-		for sourceJSONIndex, sourceJSON := range v.([]any) {
-			if sourceJSON == nil {
-				continue
-			}
-
-			sourceDoc := &IAMPolicyDoc{}
-			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
-			}
-
-			// assure all statements in sourceDoc are unique before merging
-			for stmtIndex, stmt := range sourceDoc.Statements {
-				if stmt.Sid != "" {
-					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
-					}
-					sidMap[stmt.Sid] = struct{}{}
-				}
-			}
-
-			mergedDoc.Merge(sourceDoc)
-		}
-	}
-
-	// process the current document
-	doc := &IAMPolicyDoc{
-		Version: d.Get(names.AttrVersion).(string),
+	config := &PolicyDocumentConfig{
+		Version:  d.Get(names.AttrVersion).(string),
+		PolicyID: d.Get("policy_id").(string),
 	}
 
-	if policyID, hasPolicyID := d.GetOk("policy_id"); hasPolicyID {
-		doc.Id = policyID.(string)
+	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]any)) > 0 {
+		config.SourcePolicyDocuments = aws.ToStringSlice(expandStringListKeepEmpty(v.([]any)))
 	}
 
-	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
-		var cfgStmtIntf = cfgStmts.([]any)
-		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
-		sidMap := make(map[string]struct{})
-
-		for i, stmtI := range cfgStmtIntf {
-			cfgStmt := stmtI.(map[string]any)
-			stmt := &IAMPolicyStatement{
-				Effect: cfgStmt["effect"].(string),
-			}
-
-			if sid, ok := cfgStmt["sid"]; ok {
-				if _, ok := sidMap[sid.(string)]; ok {
-					return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
-				}
-				stmt.Sid = sid.(string)
-				if len(stmt.Sid) > 0 {
-					sidMap[stmt.Sid] = struct{}{}
-				}
-			}
-
-			if actions := cfgStmt[names.AttrActions].(*schema.Set).List(); len(actions) > 0 {
-				stmt.Actions = policyDecodeConfigStringList(actions)
-			}
-			if actions := cfgStmt["not_actions"].(*schema.Set).List(); len(actions) > 0 {
-				stmt.NotActions = policyDecodeConfigStringList(actions)
+	if v, ok := d.GetOk("statement"); ok {
+		for _, tfMapRaw := range v.([]any) {
+			tfMap := tfMapRaw.(map[string]any)
+			stmt := PolicyDocumentStatementConfig{
+				Sid:          tfMap["sid"].(string),
+				Effect:       tfMap["effect"].(string),
+				Actions:      flex.ExpandStringValueEmptySet(tfMap[names.AttrActions].(*schema.Set)),
+				NotActions:   flex.ExpandStringValueEmptySet(tfMap["not_actions"].(*schema.Set)),
+				Resources:    flex.ExpandStringValueEmptySet(tfMap[names.AttrResources].(*schema.Set)),
+				NotResources: flex.ExpandStringValueEmptySet(tfMap["not_resources"].(*schema.Set)),
 			}
 
-			if resources := cfgStmt[names.AttrResources].(*schema.Set).List(); len(resources) > 0 {
-				var err error
-				stmt.Resources, err = dataSourcePolicyDocumentReplaceVarsInList(
-					policyDecodeConfigStringList(resources), doc.Version,
-				)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading resources: %s", err)
-				}
-			}
-			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
-				var err error
-				stmt.NotResources, err = dataSourcePolicyDocumentReplaceVarsInList(
-					policyDecodeConfigStringList(notResources), doc.Version,
-				)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading not_resources: %s", err)
-				}
+			for _, tfMapRaw := range tfMap["principals"].(*schema.Set).List() {
+				stmt.Principals = append(stmt.Principals, expandPolicyDocumentPrincipalConfig(tfMapRaw.(map[string]any)))
 			}
-
-			if principals := cfgStmt["principals"].(*schema.Set).List(); len(principals) > 0 {
-				var err error
-				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading principals: %s", err)
-				}
+			for _, tfMapRaw := range tfMap["not_principals"].(*schema.Set).List() {
+				stmt.NotPrincipals = append(stmt.NotPrincipals, expandPolicyDocumentPrincipalConfig(tfMapRaw.(map[string]any)))
 			}
-
-			if notPrincipals := cfgStmt["not_principals"].(*schema.Set).List(); len(notPrincipals) > 0 {
-				var err error
-				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading not_principals: %s", err)
-				}
-			}
-
-			if conditions := cfgStmt[names.AttrCondition].(*schema.Set).List(); len(conditions) > 0 {
-				var err error
-				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading condition: %s", err)
-				}
+			for _, tfMapRaw := range tfMap[names.AttrCondition].(*schema.Set).List() {
+				tfMap := tfMapRaw.(map[string]any)
+				stmt.Conditions = append(stmt.Conditions, PolicyDocumentConditionConfig{
+					Test:     tfMap["test"].(string),
+					Variable: tfMap["variable"].(string),
+					Values:   aws.ToStringSlice(expandStringListKeepEmpty(tfMap[names.AttrValues].([]any))),
+				})
 			}
 
-			stmts[i] = stmt
+			config.Statements = append(config.Statements, stmt)
 		}
-
-		doc.Statements = stmts
 	}
 
-	// merge our current document into mergedDoc
-	mergedDoc.Merge(doc)
-
-	// merge override_policy_documents policies into mergedDoc in order specified
 	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]any)) > 0 {
-		for overrideJSONIndex, overrideJSON := range v.([]any) {
-			if overrideJSON == nil {
-				continue
-			}
-			overrideDoc := &IAMPolicyDoc{}
-			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
-			}
+		config.OverridePolicyDocuments = aws.ToStringSlice(expandStringListKeepEmpty(v.([]any)))
+	}
 
-			mergedDoc.Merge(overrideDoc)
-		}
+	mergedDoc, err := BuildPolicyDocument(config)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
@@ -332,6 +243,13 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func expandPolicyDocumentPrincipalConfig(tfMap map[string]any) PolicyDocumentPrincipalConfig {
+	return PolicyDocumentPrincipalConfig{
+		Type:        tfMap[names.AttrType].(string),
+		Identifiers: flex.ExpandStringValueEmptySet(tfMap["identifiers"].(*schema.Set)),
+	}
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in any, version string) (any, error) {
 	switch v := in.(type) {
 	case string:
@@ -353,19 +271,15 @@ func dataSourcePolicyDocumentReplaceVarsInList(in any, version string) (any, err
 	}
 }
 
-func dataSourcePolicyDocumentMakeConditions(in []any, version string) (IAMPolicyStatementConditionSet, error) {
+func dataSourcePolicyDocumentMakeConditions(in []PolicyDocumentConditionConfig, version string) (IAMPolicyStatementConditionSet, error) {
 	out := make([]IAMPolicyStatementCondition, len(in))
-	for i, itemI := range in {
+	for i, item := range in {
 		var err error
-		item := itemI.(map[string]any)
 		out[i] = IAMPolicyStatementCondition{
-			Test:     item["test"].(string),
-			Variable: item["variable"].(string),
+			Test:     item.Test,
+			Variable: item.Variable,
 		}
-		out[i].Values, err = dataSourcePolicyDocumentReplaceVarsInList(
-			aws.ToStringSlice(expandStringListKeepEmpty(item[names.AttrValues].([]any))),
-			version,
-		)
+		out[i].Values, err = dataSourcePolicyDocumentReplaceVarsInList(item.Values, version)
 		if err != nil {
 			return nil, fmt.Errorf("reading values: %w", err)
 		}
@@ -377,18 +291,15 @@ func dataSourcePolicyDocumentMakeConditions(in []any, version string) (IAMPolicy
 	return IAMPolicyStatementConditionSet(out), nil
 }
 
-func dataSourcePolicyDocumentMakePrincipals(in []any, version string) (IAMPolicyStatementPrincipalSet, error) {
+func dataSourcePolicyDocumentMakePrincipals(in []PolicyDocumentPrincipalConfig, version string) (IAMPolicyStatementPrincipalSet, error) {
 	out := make([]IAMPolicyStatementPrincipal, len(in))
-	for i, itemI := range in {
+	for i, item := range in {
 		var err error
-		item := itemI.(map[string]any)
 		out[i] = IAMPolicyStatementPrincipal{
-			Type: item[names.AttrType].(string),
+			Type: item.Type,
 		}
 		out[i].Identifiers, err = dataSourcePolicyDocumentReplaceVarsInList(
-			policyDecodeConfigStringList(
-				item["identifiers"].(*schema.Set).List(),
-			), version,
+			policyDecodeConfigStringList(item.Identifiers), version,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("reading identifiers: %w", err)
@@ -235,19 +235,175 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-func policyDecodeConfigStringList(lI []any) any {
-	if len(lI) == 1 {
-		return lI[0].(string)
-	}
-	ret := make([]string, len(lI))
-	for i, vI := range lI {
-		ret[i] = vI.(string)
+func policyDecodeConfigStringList(l []string) any {
+	if len(l) == 1 {
+		return l[0]
 	}
+	ret := slices.Clone(l)
 	slices.Sort(ret)
 	slices.Reverse(ret)
 	return ret
 }
 
+// PolicyDocumentConfig is the configuration from which an IAM policy document is built.
+// It is shared by the aws_iam_policy_document data source and the iam_policy_document
+// provider function so that both produce identical documents.
+type PolicyDocumentConfig struct {
+	Version                 string
+	PolicyID                string
+	SourcePolicyDocuments   []string
+	Statements              []PolicyDocumentStatementConfig
+	OverridePolicyDocuments []string
+}
+
+type PolicyDocumentStatementConfig struct {
+	Sid           string
+	Effect        string
+	Actions       []string
+	NotActions    []string
+	Resources     []string
+	NotResources  []string
+	Principals    []PolicyDocumentPrincipalConfig
+	NotPrincipals []PolicyDocumentPrincipalConfig
+	Conditions    []PolicyDocumentConditionConfig
+}
+
+type PolicyDocumentPrincipalConfig struct {
+	Type        string
+	Identifiers []string
+}
+
+type PolicyDocumentConditionConfig struct {
+	Test     string
+	Variable string
+	Values   []string
+}
+
+// BuildPolicyDocument merges the source policy documents, the configured statements
+// and the override policy documents, in that order, into a single IAM policy document.
+func BuildPolicyDocument(config *PolicyDocumentConfig) (*IAMPolicyDoc, error) {
+	mergedDoc := &IAMPolicyDoc{}
+
+	if len(config.SourcePolicyDocuments) > 0 {
+		// generate sid map to assure there are no duplicates in source jsons
+		sidMap := make(map[string]struct{})
+
+		// merge sourceDocs in order specified
+		for sourceJSONIndex, sourceJSON := range config.SourcePolicyDocuments {
+			sourceDoc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(sourceJSON), sourceDoc); err != nil {
+				return nil, fmt.Errorf("writing IAM Policy Document: merging source document %d: %w", sourceJSONIndex, err)
+			}
+
+			// assure all statements in sourceDoc are unique before merging
+			for stmtIndex, stmt := range sourceDoc.Statements {
+				if stmt.Sid != "" {
+					if _, sidExists := sidMap[stmt.Sid]; sidExists {
+						return nil, fmt.Errorf("writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
+					}
+					sidMap[stmt.Sid] = struct{}{}
+				}
+			}
+
+			mergedDoc.Merge(sourceDoc)
+		}
+	}
+
+	// process the current document
+	doc := &IAMPolicyDoc{
+		Version: config.Version,
+		Id:      config.PolicyID,
+	}
+
+	if len(config.Statements) > 0 {
+		stmts := make([]*IAMPolicyStatement, len(config.Statements))
+		sidMap := make(map[string]struct{})
+
+		for i, cfgStmt := range config.Statements {
+			stmt := &IAMPolicyStatement{
+				Effect: cfgStmt.Effect,
+			}
+
+			if _, ok := sidMap[cfgStmt.Sid]; ok {
+				return nil, fmt.Errorf("writing IAM Policy Document: duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", cfgStmt.Sid)
+			}
+			stmt.Sid = cfgStmt.Sid
+			if len(stmt.Sid) > 0 {
+				sidMap[stmt.Sid] = struct{}{}
+			}
+
+			if len(cfgStmt.Actions) > 0 {
+				stmt.Actions = policyDecodeConfigStringList(cfgStmt.Actions)
+			}
+			if len(cfgStmt.NotActions) > 0 {
+				stmt.NotActions = policyDecodeConfigStringList(cfgStmt.NotActions)
+			}
+
+			if len(cfgStmt.Resources) > 0 {
+				var err error
+				stmt.Resources, err = dataSourcePolicyDocumentReplaceVarsInList(
+					policyDecodeConfigStringList(cfgStmt.Resources), doc.Version,
+				)
+				if err != nil {
+					return nil, fmt.Errorf("reading resources: %w", err)
+				}
+			}
+			if len(cfgStmt.NotResources) > 0 {
+				var err error
+				stmt.NotResources, err = dataSourcePolicyDocumentReplaceVarsInList(
+					policyDecodeConfigStringList(cfgStmt.NotResources), doc.Version,
+				)
+				if err != nil {
+					return nil, fmt.Errorf("reading not_resources: %w", err)
+				}
+			}
+
+			if len(cfgStmt.Principals) > 0 {
+				var err error
+				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(cfgStmt.Principals, doc.Version)
+				if err != nil {
+					return nil, fmt.Errorf("reading principals: %w", err)
+				}
+			}
+
+			if len(cfgStmt.NotPrincipals) > 0 {
+				var err error
+				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(cfgStmt.NotPrincipals, doc.Version)
+				if err != nil {
+					return nil, fmt.Errorf("reading not_principals: %w", err)
+				}
+			}
+
+			if len(cfgStmt.Conditions) > 0 {
+				var err error
+				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(cfgStmt.Conditions, doc.Version)
+				if err != nil {
+					return nil, fmt.Errorf("reading condition: %w", err)
+				}
+			}
+
+			stmts[i] = stmt
+		}
+
+		doc.Statements = stmts
+	}
+
+	// merge our current document into mergedDoc
+	mergedDoc.Merge(doc)
+
+	// merge override_policy_documents policies into mergedDoc in order specified
+	for overrideJSONIndex, overrideJSON := range config.OverridePolicyDocuments {
+		overrideDoc := &IAMPolicyDoc{}
+		if err := json.Unmarshal([]byte(overrideJSON), overrideDoc); err != nil {
+			return nil, fmt.Errorf("writing IAM Policy Document: merging override document %d: %w", overrideJSONIndex, err)
+		}
+
+		mergedDoc.Merge(overrideDoc)
+	}
+
+	return mergedDoc, nil
+}
+
 // PolicyHasValidAWSPrincipals validates that the Principals in an IAM Policy are valid
 // Assumes that non-"AWS" Principals are valid
 // The value can be a single string or a slice of strings
@@ -41,7 +41,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role", name="Role")
-// @Tags(identifierAttribute="name", resourceType="Role")
+// @Tags(identifierAttribute="name", resourceType="Role", restrictedCharacters=true)
 // @IdentityAttribute("name")
 // @WrappedImport(false)
 // @V60SDKv2Fix
@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_iam_saml_provider", name="SAML Provider")
-// @Tags(identifierAttribute="arn", resourceType="SAMLProvider")
+// @Tags(identifierAttribute="arn", resourceType="SAMLProvider", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceSAMLProvider() *schema.Resource {
 	return &schema.Resource{
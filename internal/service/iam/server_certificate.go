@@ -29,7 +29,7 @@ import (
 )
 
 // @SDKResource("aws_iam_server_certificate", name="Server Certificate")
-// @Tags(identifierAttribute="name", resourceType="ServerCertificate")
+// @Tags(identifierAttribute="name", resourceType="ServerCertificate", restrictedCharacters=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.ServerCertificate", tlsKey=true, importStateId="rName", importIgnore="private_key")
 func resourceServerCertificate() *schema.Resource {
 	return &schema.Resource{
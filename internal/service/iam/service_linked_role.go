@@ -30,7 +30,9 @@ import (
 )
 
 // @SDKResource("aws_iam_service_linked_role", name="Service Linked Role")
-// @Tags(identifierAttribute="id", resourceType="ServiceLinkedRole")
+// @Tags(identifierAttribute="id", resourceType="ServiceLinkedRole", restrictedCharacters=true)
+// @ArnIdentity
+// @Testing(preIdentityVersion="6.4.0")
 func resourceServiceLinkedRole() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServiceLinkedRoleCreate,
@@ -38,10 +40,6 @@ func resourceServiceLinkedRole() *schema.Resource {
 		UpdateWithoutTimeout: resourceServiceLinkedRoleUpdate,
 		DeleteWithoutTimeout: resourceServiceLinkedRoleDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -69,6 +69,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*inttypes.ServicePackageListResource {
+	return []*inttypes.ServicePackageListResource{
+		{
+			List:     listRoles,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
 	return []*inttypes.ServicePackageSDKDataSource{
 		{
@@ -218,20 +229,37 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_group_policy",
 			Name:     "Group Policy",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalParameterizedIdentity([]inttypes.IdentityAttribute{
+				inttypes.StringIdentityAttribute("group", true),
+				inttypes.StringIdentityAttribute(names.AttrName, true),
+			}),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+				ImportID:      groupPolicyImportID{},
+			},
 		},
 		{
 			Factory:  resourceGroupPolicyAttachment,
 			TypeName: "aws_iam_group_policy_attachment",
 			Name:     "Group Policy Attachment",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalParameterizedIdentity([]inttypes.IdentityAttribute{
+				inttypes.StringIdentityAttribute("group", true),
+				inttypes.StringIdentityAttribute("policy_arn", true),
+			}),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+				ImportID:      groupPolicyAttachmentImportID{},
+			},
 		},
 		{
 			Factory:  resourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
 			Name:     "Instance Profile",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrID,
-				ResourceType:        "InstanceProfile",
+				IdentifierAttribute:  names.AttrID,
+				ResourceType:         "InstanceProfile",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
@@ -240,20 +268,34 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_openid_connect_provider",
 			Name:     "OIDC Provider",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
-				ResourceType:        "OIDCProvider",
+				IdentifierAttribute:  names.AttrARN,
+				ResourceType:         "OIDCProvider",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalARNIdentity(
+				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
+			),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
 		},
 		{
 			Factory:  resourcePolicy,
 			TypeName: "aws_iam_policy",
 			Name:     "Policy",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
-				ResourceType:        "Policy",
+				IdentifierAttribute:  names.AttrARN,
+				ResourceType:         "Policy",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalARNIdentity(
+				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
+			),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
 		},
 		{
 			Factory:  resourcePolicyAttachment,
@@ -266,8 +308,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_role",
 			Name:     "Role",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrName,
-				ResourceType:        "Role",
+				IdentifierAttribute:  names.AttrName,
+				ResourceType:         "Role",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 			Identity: inttypes.GlobalSingleParameterIdentity(names.AttrName,
@@ -307,8 +350,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_saml_provider",
 			Name:     "SAML Provider",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
-				ResourceType:        "SAMLProvider",
+				IdentifierAttribute:  names.AttrARN,
+				ResourceType:         "SAMLProvider",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
@@ -323,8 +367,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_server_certificate",
 			Name:     "Server Certificate",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrName,
-				ResourceType:        "ServerCertificate",
+				IdentifierAttribute:  names.AttrName,
+				ResourceType:         "ServerCertificate",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
@@ -333,10 +378,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_service_linked_role",
 			Name:     "Service Linked Role",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrID,
-				ResourceType:        "ServiceLinkedRole",
+				IdentifierAttribute:  names.AttrID,
+				ResourceType:         "ServiceLinkedRole",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalARNIdentity(
+				inttypes.WithIdentityDuplicateAttrs(names.AttrID),
+			),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+			},
 		},
 		{
 			Factory:  resourceServiceSpecificCredential,
@@ -355,8 +407,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_user",
 			Name:     "User",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrName,
-				ResourceType:        "User",
+				IdentifierAttribute:  names.AttrName,
+				ResourceType:         "User",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
@@ -377,12 +430,28 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_user_policy",
 			Name:     "User Policy",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalParameterizedIdentity([]inttypes.IdentityAttribute{
+				inttypes.StringIdentityAttribute("user", true),
+				inttypes.StringIdentityAttribute(names.AttrName, true),
+			}),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+				ImportID:      userPolicyImportID{},
+			},
 		},
 		{
 			Factory:  resourceUserPolicyAttachment,
 			TypeName: "aws_iam_user_policy_attachment",
 			Name:     "User Policy Attachment",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalParameterizedIdentity([]inttypes.IdentityAttribute{
+				inttypes.StringIdentityAttribute("user", true),
+				inttypes.StringIdentityAttribute("policy_arn", true),
+			}),
+			Import: inttypes.SDKv2Import{
+				WrappedImport: true,
+				ImportID:      userPolicyAttachmentImportID{},
+			},
 		},
 		{
 			Factory:  resourceUserSSHKey,
@@ -395,8 +464,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_iam_virtual_mfa_device",
 			Name:     "Virtual MFA Device",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrID,
-				ResourceType:        "VirtualMFADevice",
+				IdentifierAttribute:  names.AttrID,
+				ResourceType:         "VirtualMFADevice",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDisabled()),
 		},
@@ -28,7 +28,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_auditmanager_assessment",
@@ -92,14 +92,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -114,6 +114,10 @@ func RegisterSweepers() {
 
 func sweepGroups(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping IAM Group sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -422,6 +426,10 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 
 func sweepRoles(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping IAM Role sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -503,6 +511,10 @@ func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 
 func sweepServerCertificates(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
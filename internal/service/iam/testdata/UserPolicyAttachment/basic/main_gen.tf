@@ -0,0 +1,36 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_iam_user_policy_attachment" "test" {
+  user       = aws_iam_user.test.name
+  policy_arn = aws_iam_policy.test.arn
+}
+
+resource "aws_iam_user" "test" {
+  name = var.rName
+}
+
+resource "aws_iam_policy" "test" {
+  name        = var.rName
+  description = "A test policy"
+
+  policy = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect = "Allow"
+    actions = [
+      "iam:ChangePassword"
+    ]
+    resources = [
+      "*"
+    ]
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -26,6 +26,12 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy", name="User Policy")
+// @IdentityAttribute("user")
+// @IdentityAttribute("name")
+// @IdAttrFormat("{user}:{name}")
+// @ImportIDHandler("userPolicyImportID")
+// @Testing(existsType="string")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceUserPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyPut,
@@ -33,10 +39,6 @@ func resourceUserPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceUserPolicyPut,
 		DeleteWithoutTimeout: resourceUserPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrName: {
 				Type:          schema.TypeString,
@@ -96,7 +98,7 @@ func resourceUserPolicyPut(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	if d.IsNewResource() {
-		d.SetId(fmt.Sprintf("%s:%s", userName, policyName))
+		d.SetId(createUserPolicyImportID(userName, policyName))
 
 		_, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
 			return FindUserPolicyByTwoPartKey(ctx, conn, userName, policyName)
@@ -201,6 +203,10 @@ func FindUserPolicyByTwoPartKey(ctx context.Context, conn *iam.Client, userName,
 	return aws.ToString(output.PolicyDocument), nil
 }
 
+func createUserPolicyImportID(userName, policyName string) string {
+	return fmt.Sprintf("%s:%s", userName, policyName)
+}
+
 func UserPolicyParseID(id string) (userName, policyName string, err error) {
 	parts := strings.SplitN(id, ":", 2)
 	if len(parts) != 2 {
@@ -212,3 +218,22 @@ func UserPolicyParseID(id string) (userName, policyName string, err error) {
 	policyName = parts[1]
 	return
 }
+
+type userPolicyImportID struct{}
+
+func (userPolicyImportID) Create(d *schema.ResourceData) string {
+	return createUserPolicyImportID(d.Get("user").(string), d.Get(names.AttrName).(string))
+}
+
+func (userPolicyImportID) Parse(id string) (string, map[string]string, error) {
+	userName, policyName, err := UserPolicyParseID(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		"user":         userName,
+		names.AttrName: policyName,
+	}
+	return id, result, nil
+}
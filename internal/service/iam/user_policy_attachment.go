@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -25,16 +24,17 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy_attachment", name="User Policy Attachment")
+// @IdentityAttribute("user")
+// @IdentityAttribute("policy_arn")
+// @IdAttrFormat("{user}/{policy_arn}")
+// @ImportIDHandler("userPolicyAttachmentImportID")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceUserPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceUserPolicyAttachmentRead,
 		DeleteWithoutTimeout: resourceUserPolicyAttachmentDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceUserPolicyAttachmentImport,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"policy_arn": {
 				Type:         schema.TypeString,
@@ -62,8 +62,7 @@ func resourceUserPolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
-	d.SetId(id.PrefixedUniqueId(fmt.Sprintf("%s-", user)))
+	d.SetId(createUserPolicyAttachmentImportID(d))
 
 	return append(diags, resourceUserPolicyAttachmentRead(ctx, d, meta)...)
 }
@@ -105,22 +104,6 @@ func resourceUserPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
-func resourceUserPolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<policy_arn>", d.Id())
-	}
-
-	userName := idParts[0]
-	policyARN := idParts[1]
-
-	d.Set("user", userName)
-	d.Set("policy_arn", policyARN)
-	d.SetId(fmt.Sprintf("%s-%s", userName, policyARN))
-
-	return []*schema.ResourceData{d}, nil
-}
-
 func attachPolicyToUser(ctx context.Context, conn *iam.Client, user, policyARN string) error {
 	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, propagationTimeout, func() (any, error) {
 		return conn.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{
@@ -202,3 +185,26 @@ func findAttachedUserPolicies(ctx context.Context, conn *iam.Client, input *iam.
 
 	return output, nil
 }
+
+func createUserPolicyAttachmentImportID(d *schema.ResourceData) string {
+	return (userPolicyAttachmentImportID{}).Create(d)
+}
+
+type userPolicyAttachmentImportID struct{}
+
+func (userPolicyAttachmentImportID) Create(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/%s", d.Get("user").(string), d.Get("policy_arn").(string))
+}
+
+func (userPolicyAttachmentImportID) Parse(id string) (string, map[string]string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("unexpected format for Import ID (%q), expected <user-name>/<policy_arn>", id)
+	}
+
+	result := map[string]string{
+		"user":       parts[0],
+		"policy_arn": parts[1],
+	}
+	return id, result, nil
+}
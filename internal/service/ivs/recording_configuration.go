@@ -28,16 +28,16 @@ import (
 
 // @SDKResource("aws_ivs_recording_configuration", name="Recording Configuration")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ivs/types;awstypes;awstypes.RecordingConfiguration")
+// @Testing(preCheck="testAccRecordingConfigurationPreCheck")
+// @Testing(preIdentityVersion="6.4.0")
 func ResourceRecordingConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRecordingConfigurationCreate,
 		ReadWithoutTimeout:   resourceRecordingConfigurationRead,
 		DeleteWithoutTimeout: resourceRecordingConfigurationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -27,6 +27,9 @@ import (
 
 // @SDKResource("aws_ivschat_room", name="Room")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ivschat;ivschat.GetRoomOutput")
+// @Testing(preIdentityVersion="6.4.0")
 func ResourceRoom() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoomCreate,
@@ -34,10 +37,6 @@ func ResourceRoom() *schema.Resource {
 		UpdateWithoutTimeout: resourceRoomUpdate,
 		DeleteWithoutTimeout: resourceRoomDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ivschat_logging_configuration" "test" {
+  destination_configuration {
+    cloudwatch_logs {
+      log_group_name = aws_cloudwatch_log_group.test.name
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.4.0"
+    }
+  }
+}
+
+provider "aws" {}
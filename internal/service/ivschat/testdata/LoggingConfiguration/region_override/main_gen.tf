@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ivschat_logging_configuration" "test" {
+  region = var.region
+
+  destination_configuration {
+    cloudwatch_logs {
+      log_group_name = aws_cloudwatch_log_group.test.name
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  region = var.region
+
+  name = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -24,6 +24,10 @@ import (
 )
 
 // @SDKResource("aws_msk_cluster_policy", name="Cluster Policy")
+// @ArnIdentity("cluster_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kafka;kafka.GetClusterPolicyOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceClusterPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterPolicyPut,
@@ -31,10 +35,6 @@ func resourceClusterPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterPolicyPut,
 		DeleteWithoutTimeout: resourceClusterPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"cluster_arn": {
 				Type:     schema.TypeString,
@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_msk_configuration", name="Configuration")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kafka;kafka.DescribeConfigurationOutput")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigurationCreate,
@@ -32,10 +35,6 @@ func resourceConfiguration() *schema.Resource {
 		UpdateWithoutTimeout: resourceConfigurationUpdate,
 		DeleteWithoutTimeout: resourceConfigurationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ComputedIf("latest_revision", func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
 				return diff.HasChange("server_properties")
@@ -28,6 +28,10 @@ import (
 
 // @SDKResource("aws_msk_replicator", name="Replicator")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kafka;kafka.DescribeReplicatorOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceReplicator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicatorCreate,
@@ -35,10 +39,6 @@ func resourceReplicator() *schema.Resource {
 		UpdateWithoutTimeout: resourceReplicatorUpdate,
 		DeleteWithoutTimeout: resourceReplicatorDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
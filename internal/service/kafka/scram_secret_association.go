@@ -29,6 +29,9 @@ const (
 )
 
 // @SDKResource("aws_msk_scram_secret_association", name="SCRAM Secret Association")
+// @ArnIdentity("cluster_arn")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceSCRAMSecretAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSCRAMSecretAssociationCreate,
@@ -36,10 +39,6 @@ func resourceSCRAMSecretAssociation() *schema.Resource {
 		UpdateWithoutTimeout: resourceSCRAMSecretAssociationUpdate,
 		DeleteWithoutTimeout: resourceSCRAMSecretAssociationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"cluster_arn": {
 				Type:         schema.TypeString,
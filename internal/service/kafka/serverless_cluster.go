@@ -25,6 +25,10 @@ import (
 
 // @SDKResource("aws_msk_serverless_cluster", name="Serverless Cluster")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kafka/types;types.Cluster")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceServerlessCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServerlessClusterCreate,
@@ -32,10 +36,6 @@ func resourceServerlessCluster() *schema.Resource {
 		UpdateWithoutTimeout: resourceServerlessClusterUpdate,
 		DeleteWithoutTimeout: resourceClusterDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(120 * time.Minute),
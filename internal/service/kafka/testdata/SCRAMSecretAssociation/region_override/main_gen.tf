@@ -0,0 +1,136 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_msk_scram_secret_association" "test" {
+  region = var.region
+
+  cluster_arn     = aws_msk_cluster.test.arn
+  secret_arn_list = [aws_secretsmanager_secret.test.arn]
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+
+resource "aws_msk_cluster" "test" {
+  region = var.region
+
+  cluster_name           = var.rName
+  kafka_version          = "2.8.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    instance_type   = "kafka.t3.small"
+    security_groups = [aws_security_group.test.id]
+
+    storage_info {
+      ebs_storage_info {
+        volume_size = 10
+      }
+    }
+  }
+
+  client_authentication {
+    sasl {
+      scram = true
+    }
+  }
+}
+
+resource "aws_security_group" "test" {
+  region = var.region
+
+  name   = var.rName
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_kms_key" "test" {
+  region = var.region
+
+  description             = var.rName
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  region = var.region
+
+  name       = "AmazonMSK_${var.rName}"
+  kms_key_id = aws_kms_key.test.id
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  region = var.region
+
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ username = "user", password = "pass" })
+}
+
+resource "aws_secretsmanager_secret_policy" "test" {
+  region = var.region
+
+  secret_arn = aws_secretsmanager_secret.test.arn
+  policy     = <<POLICY
+{
+  "Version" : "2012-10-17",
+  "Statement" : [ {
+    "Sid": "AWSKafkaResourcePolicy",
+    "Effect" : "Allow",
+    "Principal" : {
+      "Service" : "kafka.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action" : "secretsmanager:getSecretValue",
+    "Resource" : "${aws_secretsmanager_secret.test.arn}"
+  } ]
+}
+POLICY
+}
+
+data "aws_partition" "current" {}
+
+# acctest.ConfigVPCWithSubnets(rName, 3)
+
+resource "aws_vpc" "test" {
+  region = var.region
+
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  region = var.region
+
+  count = 3
+
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+}
+
+# acctest.ConfigAvailableAZsNoOptInDefaultExclude
+
+data "aws_availability_zones" "available" {
+  region = var.region
+
+  exclude_zone_ids = local.default_exclude_zone_ids
+  state            = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+locals {
+  default_exclude_zone_ids = ["usw2-az4", "usgw1-az2"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
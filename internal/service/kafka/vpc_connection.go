@@ -26,6 +26,10 @@ import (
 
 // @SDKResource("aws_msk_vpc_connection", name="VPC Connection")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kafka;kafka.DescribeVpcConnectionOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceVPCConnection() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCConnectionCreate,
@@ -33,10 +37,6 @@ func resourceVPCConnection() *schema.Resource {
 		UpdateWithoutTimeout: resourceVPCConnectionUpdate,
 		DeleteWithoutTimeout: resourceVPCConnectionDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
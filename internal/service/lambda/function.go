@@ -42,8 +42,11 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("function_name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
+// @Testing(idAttrDuplicates="function_name")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceFunction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionCreate,
@@ -57,13 +60,6 @@ func resourceFunction() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-				d.Set("function_name", d.Id())
-				return []*schema.ResourceData{d}, nil
-			},
-		},
-
 		Schema: map[string]*schema.Schema{
 			"architectures": {
 				Type:     schema.TypeList,
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @ListResource("aws_lambda_function", name="Function")
+func listFunctions(ctx context.Context, meta any) iter.Seq2[inttypes.ListResult, error] {
+	return func(yield func(inttypes.ListResult, error) bool) {
+		conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+		input := lambda.ListFunctionsInput{}
+
+		pages := lambda.NewListFunctionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(inttypes.ListResult{}, fmt.Errorf("listing Lambda Functions: %w", err))
+				return
+			}
+
+			for _, v := range page.Functions {
+				name := aws.ToString(v.FunctionName)
+				result := inttypes.ListResult{
+					ID:          name,
+					DisplayName: name,
+					Identity: map[string]string{
+						"function_name": name,
+					},
+				}
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -27,6 +27,9 @@ import (
 
 // @SDKResource("aws_licensemanager_license_configuration", name="License Configuration")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/licensemanager;licensemanager.GetLicenseConfigurationOutput")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceLicenseConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLicenseConfigurationCreate,
@@ -34,10 +37,6 @@ func resourceLicenseConfiguration() *schema.Resource {
 		UpdateWithoutTimeout: resourceLicenseConfigurationUpdate,
 		DeleteWithoutTimeout: resourceLicenseConfigurationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
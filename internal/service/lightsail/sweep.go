@@ -16,42 +16,42 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
 		Name: "aws_lightsail_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
 		Name: "aws_lightsail_disk",
 		F:    sweepDisks,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
 		Name: "aws_lightsail_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
 		Name: "aws_lightsail_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
 		Name: "aws_lightsail_lb",
 		F:    sweepLoadBalancers,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -269,6 +269,10 @@ func sweepDomains(region string) error {
 
 func sweepInstances(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping Lightsail Instance sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
@@ -361,6 +365,10 @@ func sweepLoadBalancers(region string) error {
 
 func sweepStaticIPs(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DescribedOnly() {
+		log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: dry run and filtering not supported", region)
+		return nil
+	}
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
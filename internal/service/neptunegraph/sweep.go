@@ -36,27 +36,32 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 		for _, v := range page.Graphs {
 			id := aws.ToString(v.Id)
+			var sweepResource sweep.Sweepable = framework.NewSweepResource(newGraphResource, client,
+				framework.NewAttribute(names.AttrID, id))
 
 			if aws.ToBool(v.DeletionProtection) {
-				input := neptunegraph.UpdateGraphInput{
-					DeletionProtection: aws.Bool(false),
-					GraphIdentifier:    aws.String(id),
-				}
+				sweepResource = sweep.WithPreDelete(sweepResource, func(ctx context.Context) error {
+					input := neptunegraph.UpdateGraphInput{
+						DeletionProtection: aws.Bool(false),
+						GraphIdentifier:    aws.String(id),
+					}
 
-				if _, err := conn.UpdateGraph(ctx, &input); err != nil {
-					return nil, fmt.Errorf("updating Graph (%s) DeletionProtection: %w", id, err)
-				}
+					if _, err := conn.UpdateGraph(ctx, &input); err != nil {
+						return fmt.Errorf("updating Graph (%s) DeletionProtection: %w", id, err)
+					}
 
-				const (
-					timeout = 30 * time.Minute
-				)
-				if _, err := waitGraphUpdated(ctx, conn, id, timeout); err != nil {
-					return nil, fmt.Errorf("waiting for Graph (%s) update: %w", id, err)
-				}
+					const (
+						timeout = 30 * time.Minute
+					)
+					if _, err := waitGraphUpdated(ctx, conn, id, timeout); err != nil {
+						return fmt.Errorf("waiting for Graph (%s) update: %w", id, err)
+					}
+
+					return nil
+				})
 			}
 
-			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
-				framework.NewAttribute(names.AttrID, id)))
+			sweepResources = append(sweepResources, sweepResource)
 		}
 	}
 
@@ -42,7 +42,7 @@ const (
 )
 
 // @SDKResource("aws_rds_cluster", name="Cluster")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_rds_cluster_endpoint", name="Cluster Endpoint")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceClusterEndpoint() *schema.Resource {
 	return &schema.Resource{
@@ -29,7 +29,7 @@ import (
 )
 
 // @SDKResource("aws_rds_cluster_instance", name="Cluster Instance")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceClusterInstance() *schema.Resource {
 	return &schema.Resource{
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_rds_cluster_parameter_group", name="Cluster Parameter Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
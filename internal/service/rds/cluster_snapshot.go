@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_db_cluster_snapshot", name="DB Cluster Snapshot")
-// @Tags(identifierAttribute="db_cluster_snapshot_arn")
+// @Tags(identifierAttribute="db_cluster_snapshot_arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceClusterSnapshot() *schema.Resource {
 	return &schema.Resource{
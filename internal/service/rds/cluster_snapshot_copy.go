@@ -36,7 +36,7 @@ import (
 )
 
 // @FrameworkResource("aws_rds_cluster_snapshot_copy", name="Cluster Snapshot Copy")
-// @Tags(identifierAttribute="db_cluster_snapshot_arn")
+// @Tags(identifierAttribute="db_cluster_snapshot_arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func newClusterSnapshotCopyResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &clusterSnapshotCopyResource{}
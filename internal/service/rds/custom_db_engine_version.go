@@ -32,7 +32,7 @@ import (
 )
 
 // @SDKResource("aws_rds_custom_db_engine_version", name="Custom DB Engine Version")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceCustomDBEngineVersion() *schema.Resource {
 	return &schema.Resource{
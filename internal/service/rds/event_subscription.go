@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_db_event_subscription", name="Event Subscription")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceEventSubscription() *schema.Resource {
 	return &schema.Resource{
@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_rds_global_cluster", name="Global Cluster")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.GlobalCluster")
 func resourceGlobalCluster() *schema.Resource {
 	return &schema.Resource{
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral Resource IAM Auth Token"
+)
+
+// @EphemeralResource("aws_rds_iam_auth_token", name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEndpoint: schema.StringAttribute{
+				Required:    true,
+				Description: "Hostname of the DB instance, DB cluster or RDS Proxy endpoint.",
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required:    true,
+				Description: "Port number of the endpoint.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Authentication token to use as the database user's password.",
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required:    true,
+				Description: "Database user name.",
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data iamAuthTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Endpoint.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+	token, err := buildIAMAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx), time.Now())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Endpoint types.String `tfsdk:"endpoint"`
+	Port     types.Int64  `tfsdk:"port"`
+	Token    types.String `tfsdk:"token"`
+	Username types.String `tfsdk:"username"`
+}
+
+const (
+	iamAuthTokenExpiresIn = 15 * time.Minute
+	// Hex-encoded SHA-256 hash of an empty request payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// buildIAMAuthToken returns an IAM database authentication token for the specified endpoint (host:port), Region and database user.
+// The token is a presigned URL (without scheme) for the RDS "connect" action, as generated by the AWS SDKs.
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+func buildIAMAuthToken(ctx context.Context, endpoint, region, username string, credentialsProvider aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u := url.URL{
+		Scheme: "https",
+		Host:   endpoint,
+		Path:   "/",
+		RawQuery: url.Values{
+			"Action":        []string{"connect"},
+			"DBUser":        []string{username},
+			"X-Amz-Expires": []string{strconv.Itoa(int(iamAuthTokenExpiresIn.Seconds()))},
+		}.Encode(),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, req, emptyPayloadHash, "rds-db", region, signingTime.UTC())
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
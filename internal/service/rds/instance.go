@@ -52,7 +52,7 @@ import (
 //    - called "identifier" in the schema/state (previously was also "id")
 
 // @SDKResource("aws_db_instance", name="DB Instance")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBInstance")
 // @Testing(importIgnore="apply_immediately;password")
 func resourceInstance() *schema.Resource {
@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_db_option_group", name="DB Option Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceOptionGroup() *schema.Resource {
 	return &schema.Resource{
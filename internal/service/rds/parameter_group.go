@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_db_parameter_group", name="DB Parameter Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
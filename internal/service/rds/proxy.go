@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_db_proxy", name="DB Proxy")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceProxy() *schema.Resource {
 	return &schema.Resource{
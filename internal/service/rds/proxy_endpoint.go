@@ -28,7 +28,7 @@ import (
 )
 
 // @SDKResource("aws_db_proxy_endpoint", name="DB Proxy Endpoint")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceProxyEndpoint() *schema.Resource {
 	return &schema.Resource{
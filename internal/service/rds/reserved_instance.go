@@ -24,7 +24,7 @@ import (
 )
 
 // @SDKResource("aws_rds_reserved_instance", name="Reserved Instance")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceReservedInstance() *schema.Resource {
 	return &schema.Resource{
@@ -18,6 +18,17 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newIAMAuthTokenEphemeralResource,
+			TypeName: "aws_rds_iam_auth_token",
+			Name:     "IAM Auth Token",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
@@ -36,7 +47,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_rds_cluster_snapshot_copy",
 			Name:     "Cluster Snapshot Copy",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: "db_cluster_snapshot_arn",
+				IdentifierAttribute:  "db_cluster_snapshot_arn",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -57,7 +69,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_rds_integration",
 			Name:     "Integration",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 			Identity: inttypes.RegionalARNIdentity(inttypes.WithIdentityDuplicateAttrs(names.AttrID)),
@@ -70,7 +83,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_rds_shard_group",
 			Name:     "Shard Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -177,7 +191,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_cluster_snapshot",
 			Name:     "DB Cluster Snapshot",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: "db_cluster_snapshot_arn",
+				IdentifierAttribute:  "db_cluster_snapshot_arn",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -186,7 +201,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_event_subscription",
 			Name:     "Event Subscription",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -195,7 +211,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_instance",
 			Name:     "DB Instance",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -216,7 +233,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_option_group",
 			Name:     "DB Option Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -225,7 +243,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_parameter_group",
 			Name:     "DB Parameter Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -234,7 +253,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_proxy",
 			Name:     "DB Proxy",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -249,7 +269,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_proxy_endpoint",
 			Name:     "DB Proxy Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -264,7 +285,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_snapshot",
 			Name:     "DB Snapshot",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: "db_snapshot_arn",
+				IdentifierAttribute:  "db_snapshot_arn",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -273,7 +295,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_snapshot_copy",
 			Name:     "DB Snapshot Copy",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: "db_snapshot_arn",
+				IdentifierAttribute:  "db_snapshot_arn",
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -282,7 +305,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_db_subnet_group",
 			Name:     "DB Subnet Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -303,7 +327,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_cluster",
 			Name:     "Cluster",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -318,7 +343,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_cluster_endpoint",
 			Name:     "Cluster Endpoint",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -327,7 +353,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_cluster_instance",
 			Name:     "Cluster Instance",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -336,7 +363,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_cluster_parameter_group",
 			Name:     "Cluster Parameter Group",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -351,7 +379,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_custom_db_engine_version",
 			Name:     "Custom DB Engine Version",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -360,7 +389,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_global_cluster",
 			Name:     "Global Cluster",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -369,7 +399,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_rds_reserved_instance",
 			Name:     "Reserved Instance",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
-				IdentifierAttribute: names.AttrARN,
+				IdentifierAttribute:  names.AttrARN,
+				RestrictedCharacters: true,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
@@ -38,7 +38,7 @@ import (
 )
 
 // @FrameworkResource("aws_rds_shard_group", name="Shard Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func newShardGroupResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &shardGroupResource{}
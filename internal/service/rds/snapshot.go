@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_db_snapshot", name="DB Snapshot")
-// @Tags(identifierAttribute="db_snapshot_arn")
+// @Tags(identifierAttribute="db_snapshot_arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceSnapshot() *schema.Resource {
 	return &schema.Resource{
@@ -25,7 +25,7 @@ import (
 )
 
 // @SDKResource("aws_db_snapshot_copy", name="DB Snapshot Copy")
-// @Tags(identifierAttribute="db_snapshot_arn")
+// @Tags(identifierAttribute="db_snapshot_arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceSnapshotCopy() *schema.Resource {
 	return &schema.Resource{
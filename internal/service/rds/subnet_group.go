@@ -26,7 +26,7 @@ import (
 )
 
 // @SDKResource("aws_db_subnet_group", name="DB Subnet Group")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", restrictedCharacters=true)
 // @Testing(tagsTest=false)
 func resourceSubnetGroup() *schema.Resource {
 	return &schema.Resource{
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_s3_bucket", name="Bucket")
+func listBuckets(ctx context.Context, meta any) iter.Seq2[inttypes.ListResult, error] {
+	return func(yield func(inttypes.ListResult, error) bool) {
+		c := meta.(*conns.AWSClient)
+		conn := c.S3Client(ctx)
+		input := s3.ListBucketsInput{
+			BucketRegion: aws.String(c.Region(ctx)),
+		}
+
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(inttypes.ListResult{}, fmt.Errorf("listing S3 Buckets: %w", err))
+				return
+			}
+
+			for _, v := range page.Buckets {
+				name := aws.ToString(v.Name)
+				result := inttypes.ListResult{
+					ID:          name,
+					DisplayName: name,
+					Identity: map[string]string{
+						names.AttrBucket: name,
+					},
+				}
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -42,7 +42,7 @@ import (
 )
 
 // @SDKResource("aws_s3_object", name="Object")
-// @Tags(identifierAttribute="arn", resourceType="Object")
+// @Tags(identifierAttribute="arn", resourceType="Object", maxTags=10)
 // @IdentityAttribute("bucket")
 // @IdentityAttribute("key")
 // @IdAttrFormat("{bucket}/{key}")
@@ -31,7 +31,7 @@ import (
 )
 
 // @SDKResource("aws_s3_object_copy", name="Object Copy")
-// @Tags(identifierAttribute="arn", resourceType="ObjectCopy")
+// @Tags(identifierAttribute="arn", resourceType="ObjectCopy", maxTags=10)
 // @NoImport
 func resourceObjectCopy() *schema.Resource {
 	return &schema.Resource{
@@ -247,7 +247,9 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
 // @Testing(existsType="map[string]string")
+// @Testing(preIdentityVersion="6.4.0")
 func resourceTopic() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicCreate,
@@ -255,10 +257,6 @@ func resourceTopic() *schema.Resource {
 		UpdateWithoutTimeout: resourceTopicUpdate,
 		DeleteWithoutTimeout: resourceTopicDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: resourceTopicCustomizeDiff,
 
 		Schema: topicSchema,
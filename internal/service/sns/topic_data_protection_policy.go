@@ -24,6 +24,9 @@ import (
 )
 
 // @SDKResource("aws_sns_topic_data_protection_policy", name="Topic Data Protection Policy")
+// @ArnIdentity
+// @Testing(hasExistsFunction=false)
+// @Testing(preIdentityVersion="6.4.0")
 func resourceTopicDataProtectionPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicDataProtectionPolicyUpsert,
@@ -31,10 +34,6 @@ func resourceTopicDataProtectionPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceTopicDataProtectionPolicyUpsert,
 		DeleteWithoutTimeout: resourceTopicDataProtectionPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:         schema.TypeString,
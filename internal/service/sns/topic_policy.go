@@ -23,6 +23,9 @@ import (
 )
 
 // @SDKResource("aws_sns_topic_policy", name="Topic Policy")
+// @ArnIdentity
+// @Testing(hasExistsFunction=false)
+// @Testing(preIdentityVersion="6.4.0")
 func resourceTopicPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicPolicyUpsert,
@@ -30,10 +33,6 @@ func resourceTopicPolicy() *schema.Resource {
 		UpdateWithoutTimeout: resourceTopicPolicyUpsert,
 		DeleteWithoutTimeout: resourceTopicPolicyDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:         schema.TypeString,
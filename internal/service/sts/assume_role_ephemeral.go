@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// A newly created IAM Role, or changes to its trust policy, may not yet be visible to STS.
+	// Kept short as AccessDenied is more often a misconfigured trust policy than propagation delay.
+	iamPropagationTimeout = 15 * time.Second
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newAssumeRoleEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleEphemeralResourceModel]
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed:    true,
+				Description: "ARN of the assumed role session.",
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Duration, in seconds, of the role session.",
+				Validators: []validator.Int64{
+					int64validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional:    true,
+				Description: "A unique identifier that might be required when you assume a role in another account.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:\/\-]*$`), ""),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType:  fwtypes.IAMPolicyType,
+				Optional:    true,
+				Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				Optional:    true,
+				Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(10),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				Description: "Amazon Resource Name (ARN) of the IAM Role to assume.",
+			},
+			"role_session_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "An identifier for the assumed role session.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source identity specified by the principal assuming the role.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Optional:    true,
+				Description: "Assume role session tags.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(50),
+				},
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				Description: "Assume role session tag keys to pass to any subsequent sessions.",
+			},
+		},
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data assumeRoleEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	if data.RoleSessionName.IsNull() || data.RoleSessionName.IsUnknown() {
+		data.RoleSessionName = types.StringValue(sdkid.PrefixedUniqueId("terraform-"))
+	}
+
+	roleARN := data.RoleARN.ValueString()
+	input := sts.AssumeRoleInput{
+		DurationSeconds:   fwflex.Int32FromFrameworkInt64(ctx, data.DurationSeconds),
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		RoleArn:           aws.String(roleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+		input.Tags = append(input.Tags, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout, func() (any, error) {
+		return conn.AssumeRole(ctx, &input)
+	}, errCodeAccessDenied, "is not authorized to perform: sts:AssumeRole")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("assuming IAM Role (%s)", roleARN), err.Error())
+
+		return
+	}
+
+	output := outputRaw.(*sts.AssumeRoleOutput)
+	credentials := output.Credentials
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.ARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+	data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(credentials.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id"`
+	ARN               types.String        `tfsdk:"arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	DurationSeconds   types.Int64         `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	RoleSessionName   types.String        `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key"`
+	SessionToken      types.String        `tfsdk:"session_token"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	deleted *atomic.Int32
+}
+
+func (s testSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	s.deleted.Add(1)
+
+	return nil
+}
+
+type testDescribableSweepable struct {
+	testSweepable
+	description ResourceDescription
+}
+
+func (s testDescribableSweepable) Describe(ctx context.Context) ResourceDescription {
+	return s.description
+}
+
+func TestSweepOptionsSweep(t *testing.T) {
+	t.Parallel()
+
+	descriptions := []ResourceDescription{
+		{
+			ID:     "id-1",
+			Name:   "team-a-one",
+			Region: "us-west-2",
+			Tags:   map[string]string{"Team": "a"},
+		},
+		{
+			ID:     "id-2",
+			Name:   "team-b-two",
+			Region: "us-west-2",
+			Tags:   map[string]string{"Team": "b"},
+		},
+		{
+			ID:     "team-a-three",
+			Region: "us-west-2",
+		},
+	}
+
+	testCases := map[string]struct {
+		options         sweepOptions
+		expectedDeleted int32
+		expectedIDs     []string
+	}{
+		"no filters": {
+			options:         sweepOptions{},
+			expectedDeleted: 4,
+			expectedIDs:     []string{"id-1", "id-2", "team-a-three"},
+		},
+		"dry run": {
+			options:         sweepOptions{dryRun: true},
+			expectedDeleted: 0,
+			expectedIDs:     []string{"id-1", "id-2", "team-a-three"},
+		},
+		"name prefix": {
+			options:         sweepOptions{namePrefixes: []string{"team-a-"}},
+			expectedDeleted: 2,
+			expectedIDs:     []string{"id-1", "team-a-three"},
+		},
+		"multiple name prefixes": {
+			options:         sweepOptions{namePrefixes: []string{"team-b-", "team-c-"}},
+			expectedDeleted: 1,
+			expectedIDs:     []string{"id-2"},
+		},
+		"tags": {
+			options:         sweepOptions{tags: map[string]string{"Team": "a"}},
+			expectedDeleted: 1,
+			expectedIDs:     []string{"id-1"},
+		},
+		"dry run name prefix and tags": {
+			options:         sweepOptions{dryRun: true, namePrefixes: []string{"team-"}, tags: map[string]string{"Team": "b"}},
+			expectedDeleted: 0,
+			expectedIDs:     []string{"id-2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := log.WithResourceType(context.Background(), "aws_example_thing")
+
+			var deleted atomic.Int32
+			sweepables := []Sweepable{
+				// Cannot be described, so is only swept when neither filtering nor in dry run mode.
+				testSweepable{deleted: &deleted},
+			}
+			for _, description := range descriptions {
+				sweepables = append(sweepables, testDescribableSweepable{
+					testSweepable: testSweepable{deleted: &deleted},
+					description:   description,
+				})
+			}
+
+			o := testCase.options
+			o.report = report.New(o.dryRun)
+
+			if err := o.sweep(ctx, sweepables); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := deleted.Load(), testCase.expectedDeleted; got != expected {
+				t.Errorf("expected %d resources deleted, got %d", expected, got)
+			}
+
+			resources := o.report.Resources()
+			var ids []string
+			for _, r := range resources {
+				ids = append(ids, r.ID)
+
+				if got, expected := r.Type, "aws_example_thing"; got != expected {
+					t.Errorf("expected type %q, got %q", expected, got)
+				}
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, testCase.expectedIDs) {
+				t.Errorf("expected %v reported, got %v", testCase.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestSweepOptionsSweepWithPreDelete(t *testing.T) {
+	t.Parallel()
+
+	descriptions := []ResourceDescription{
+		{
+			ID:   "id-1",
+			Name: "team-a-one",
+		},
+		{
+			ID:   "id-2",
+			Name: "team-b-two",
+		},
+	}
+
+	testCases := map[string]struct {
+		options            sweepOptions
+		expectedPreDeleted []string
+	}{
+		"no filters": {
+			options:            sweepOptions{},
+			expectedPreDeleted: []string{"id-1", "id-2"},
+		},
+		"dry run": {
+			options: sweepOptions{dryRun: true},
+		},
+		"name prefix": {
+			options:            sweepOptions{namePrefixes: []string{"team-a-"}},
+			expectedPreDeleted: []string{"id-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var deleted atomic.Int32
+			var lock sync.Mutex
+			var preDeleted []string
+			var sweepables []Sweepable
+			for _, description := range descriptions {
+				sweepable := WithPreDelete(testDescribableSweepable{
+					testSweepable: testSweepable{deleted: &deleted},
+					description:   description,
+				}, func(context.Context) error {
+					lock.Lock()
+					defer lock.Unlock()
+
+					preDeleted = append(preDeleted, description.ID)
+
+					return nil
+				})
+
+				if _, ok := sweepable.(Describer); !ok {
+					t.Fatal("expected sweepable to be a Describer")
+				}
+
+				sweepables = append(sweepables, sweepable)
+			}
+
+			o := testCase.options
+			if err := o.sweep(ctx, sweepables); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			slices.Sort(preDeleted)
+			if !slices.Equal(preDeleted, testCase.expectedPreDeleted) {
+				t.Errorf("expected %v pre-deleted, got %v", testCase.expectedPreDeleted, preDeleted)
+			}
+			if got, expected := deleted.Load(), int32(len(testCase.expectedPreDeleted)); got != expected {
+				t.Errorf("expected %d resources deleted, got %d", expected, got)
+			}
+		})
+	}
+}
+
+func TestWithPreDeleteError(t *testing.T) {
+	t.Parallel()
+
+	var deleted atomic.Int32
+	sweepable := WithPreDelete(testSweepable{deleted: &deleted}, func(context.Context) error {
+		return errors.New("disabling deletion protection")
+	})
+
+	if _, ok := sweepable.(Describer); ok {
+		t.Error("expected sweepable not to be a Describer")
+	}
+	if err := sweepable.Delete(context.Background()); err == nil {
+		t.Error("expected error, got none")
+	}
+	if got := deleted.Load(); got != 0 {
+		t.Errorf("expected no resources deleted, got %d", got)
+	}
+}
+
+type countingDescribableSweepable struct {
+	testSweepable
+	described *atomic.Int32
+}
+
+func (s countingDescribableSweepable) Describe(ctx context.Context) ResourceDescription {
+	s.described.Add(1)
+
+	return ResourceDescription{ID: "id-1"}
+}
+
+func TestSweepOptionsSweepDescribesOnlyWhenNecessary(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options           sweepOptions
+		report            bool
+		expectedDescribed int32
+	}{
+		"no filters": {
+			options: sweepOptions{},
+		},
+		"report": {
+			options:           sweepOptions{},
+			report:            true,
+			expectedDescribed: 1,
+		},
+		"dry run": {
+			options:           sweepOptions{dryRun: true},
+			expectedDescribed: 1,
+		},
+		"tags": {
+			options:           sweepOptions{tags: map[string]string{"Team": "a"}},
+			expectedDescribed: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var deleted, described atomic.Int32
+			o := testCase.options
+			if testCase.report {
+				o.report = report.New(o.dryRun)
+			}
+
+			if err := o.sweep(context.Background(), []Sweepable{
+				countingDescribableSweepable{
+					testSweepable: testSweepable{deleted: &deleted},
+					described:     &described,
+				},
+			}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := described.Load(), testCase.expectedDescribed; got != expected {
+				t.Errorf("expected %d resources described, got %d", expected, got)
+			}
+		})
+	}
+}
+
+func TestReportMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	r := report.New(true)
+	r.Add(ResourceDescription{
+		Type:   "aws_example_thing",
+		ID:     "id-1",
+		Region: "us-west-2",
+		Tags:   map[string]string{"Team": "a"},
+	})
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := string(b), `{"dry_run":true,"resources":[{"type":"aws_example_thing","id":"id-1","region":"us-west-2","tags":{"Team":"a"}}]}`; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      map[string]string
+		expectedError bool
+	}{
+		"empty": {
+			input: "",
+		},
+		"single": {
+			input:    "Team=a",
+			expected: map[string]string{"Team": "a"},
+		},
+		"multiple": {
+			input:    "Team=a,Owner=",
+			expected: map[string]string{"Team": "a", "Owner": ""},
+		},
+		"missing value": {
+			input:         "Team",
+			expectedError: true,
+		},
+		"missing key": {
+			input:         "=a",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTags(testCase.input)
+
+			if got, expected := err != nil, testCase.expectedError; got != expected {
+				t.Fatalf("expected error %t, got %v", expected, err)
+			}
+			if !maps.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
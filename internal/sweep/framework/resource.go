@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+	sweeptags "github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -45,63 +50,247 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// Describe describes the resource.
+// Sweepers typically only set the resource's ID, so if the resource has a name or tags that are not set
+// the resource is read to determine them.
+func (sr *sweepResource) Describe(ctx context.Context) report.Resource {
+	r := report.Resource{
+		Region: sr.meta.Region(ctx),
+	}
+
+	for _, attr := range sr.attributes {
+		switch attr.path {
+		case names.AttrID:
+			r.ID = fmt.Sprint(attr.value)
+		case names.AttrName:
+			r.Name = fmt.Sprint(attr.value)
+		case names.AttrTags:
+			if v, ok := attr.value.(map[string]string); ok && len(v) > 0 {
+				r.Tags = v
+			}
+		}
+	}
+
+	// Resources without an "id" attribute are identified by their first attribute.
+	if r.ID == "" && len(sr.attributes) > 0 {
+		r.ID = fmt.Sprint(sr.attributes[0].value)
+	}
+
+	if r.Name == "" || len(r.Tags) == 0 {
+		if err := sr.describeFromRead(ctx, &r); err != nil {
+			tflog.Warn(ctx, "Reading resource to describe it", map[string]any{
+				"id":    r.ID,
+				"error": err.Error(),
+			})
+		}
+	}
+
+	return r
+}
+
+// describeFromRead reads the resource and sets any of its name and tags that are not already set in r.
+func (sr *sweepResource) describeFromRead(ctx context.Context, r *report.Resource) error {
+	resource, schema, err := sr.configure(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, hasName := schema.Attributes[names.AttrName]
+	_, hasTags := schema.Attributes[names.AttrTags]
+	if !(hasName && r.Name == "") && !(hasTags && len(r.Tags) == 0) {
+		return nil
+	}
+
+	ctx = sweeptags.NewContext(ctx, sr.meta)
+
+	state, err := sr.readResource(ctx, resource, schema)
+	if errs.Contains(err, "Value Conversion Error") {
+		// Hack for per-resource Region override.
+		state, err = sr.readResource(ctx, resource, withRegionAttribute(schema))
+	}
+	if err != nil {
+		return err
+	}
+
+	if state.Raw.IsNull() {
+		// The resource no longer exists.
+		return nil
+	}
+
+	if hasName && r.Name == "" {
+		v, err := stateString(state, names.AttrName)
+		if err != nil {
+			return err
+		}
+		r.Name = v
+	}
+
+	if hasTags && len(r.Tags) == 0 {
+		v, err := stateStringMap(state, names.AttrTags)
+		if err != nil {
+			return err
+		}
+
+		// Most Read handlers record tags in Context rather than setting them.
+		if len(v) == 0 {
+			var ok bool
+			v, ok = sweeptags.FromContext(ctx)
+			if !ok {
+				if _, err := sweeptags.List(ctx, sr.meta, func(h interceptors.HTags) string {
+					return h.GetIdentifierFramework(ctx, state)
+				}); err != nil {
+					return err
+				}
+				v, _ = sweeptags.FromContext(ctx)
+			}
+		}
+
+		if len(v) > 0 {
+			r.Tags = v
+		}
+	}
+
+	return nil
+}
+
+func (sr *sweepResource) readResource(ctx context.Context, resource fwresource.Resource, schema rschema.Schema) (tfsdk.State, error) {
+	state, err := sr.newState(ctx, schema)
+	if err != nil {
+		return tfsdk.State{}, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	return response.State, fwdiag.DiagnosticsError(response.Diagnostics)
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, schema, err := sr.configure(ctx)
 	if err != nil {
 		return err
 	}
 
+	state, err := sr.newState(ctx, schema)
+	if err != nil {
+		return err
+	}
+	for _, attr := range sr.attributes {
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	tflog.Info(ctx, "Sweeping resource")
+
+	err = deleteResource(ctx, state, resource)
+
+	if errs.Contains(err, "Value Conversion Error") {
+		// Hack for per-resource Region override.
+		// Inject a top-level region attribute into the schema and retry.
+		state, err := sr.newState(ctx, withRegionAttribute(schema))
+		if err != nil {
+			return err
+		}
+
+		err = deleteResource(ctx, state, resource)
+	}
+
+	return err
+}
+
+// configure returns the configured resource and its schema.
+func (sr *sweepResource) configure(ctx context.Context) (fwresource.Resource, rschema.Schema, error) {
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return nil, rschema.Schema{}, err
+	}
+
 	var configureResp fwresource.ConfigureResponse
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &configureResp)
 	if configureResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(configureResp.Diagnostics)
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(configureResp.Diagnostics)
 	}
 
 	var schemaResp fwresource.SchemaResponse
 	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
 	if schemaResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
 	}
 
+	return resource, schemaResp.Schema, nil
+}
+
+// newState returns a state with the sweeper's attributes set.
+func (sr *sweepResource) newState(ctx context.Context, schema rschema.Schema) (tfsdk.State, error) {
 	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
 	}
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
-	tflog.Info(ctx, "Sweeping resource")
+	return state, nil
+}
 
-	err = deleteResource(ctx, state, resource)
+// withRegionAttribute returns a copy of schema with a top-level region attribute.
+func withRegionAttribute(schema rschema.Schema) rschema.Schema {
+	attributes := maps.Clone(schema.Attributes)
+	attributes[names.AttrRegion] = rschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+	}
+	schema.Attributes = attributes
 
-	if errs.Contains(err, "Value Conversion Error") {
-		// Hack for per-resource Region override.
-		// Inject a top-level region attribute into the schema and retry.
-		schema := state.Schema.(rschema.Schema)
-		schema.Attributes[names.AttrRegion] = rschema.StringAttribute{
-			Optional: true,
-			Computed: true,
-		}
-		state := tfsdk.State{
-			Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
-			Schema: schema,
-		}
-		for _, attr := range sr.attributes {
-			d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-			if d.HasError() {
-				return fwdiag.DiagnosticsError(d)
-			}
+	return schema
+}
+
+func stateString(state tfsdk.State, attributeName string) (string, error) {
+	v, err := stateValue(state, attributeName)
+	if err != nil || v.IsNull() || !v.IsKnown() {
+		return "", err
+	}
+
+	var s string
+	if err := v.As(&s); err != nil {
+		return "", err
+	}
+
+	return s, nil
+}
+
+func stateStringMap(state tfsdk.State, attributeName string) (map[string]string, error) {
+	v, err := stateValue(state, attributeName)
+	if err != nil || v.IsNull() || !v.IsKnown() {
+		return nil, err
+	}
+
+	var elements map[string]tftypes.Value
+	if err := v.As(&elements); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string, len(elements))
+	for k, v := range elements {
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
 		}
+		m[k] = s
+	}
 
-		err = deleteResource(ctx, state, resource)
+	return m, nil
+}
+
+func stateValue(state tfsdk.State, attributeName string) (tftypes.Value, error) {
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(attributeName))
+	if err != nil {
+		return tftypes.Value{}, err
 	}
 
-	return err
+	return v.(tftypes.Value), nil
 }
 
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestReportImportConfig(t *testing.T) {
+	t.Parallel()
+
+	r := report.New(true)
+	r.Add(ResourceDescription{
+		Type:   "aws_s3_bucket",
+		ID:     "example-bucket",
+		Region: "us-west-2",
+	})
+	r.Add(ResourceDescription{
+		Type:   "aws_iam_role",
+		ID:     "Example.Role",
+		Name:   "Example.Role",
+		Region: "us-west-2",
+	})
+	r.Add(ResourceDescription{
+		Type:   "aws_instance",
+		ID:     "i-0123456789abcdef1",
+		Name:   "web",
+		Region: "us-west-2",
+	})
+	r.Add(ResourceDescription{
+		Type:   "aws_instance",
+		ID:     "i-0123456789abcdef0",
+		Name:   "web",
+		Region: "us-west-2",
+	})
+	r.Add(ResourceDescription{
+		Type:   "aws_sns_topic",
+		ID:     "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+		Region: "us-west-2",
+	})
+	r.Add(ResourceDescription{
+		Type:   "aws_example_thing",
+		ID:     "123${abc}",
+		Region: "us-west-2",
+	})
+
+	identities := map[string]inttypes.Identity{
+		"aws_iam_role":  inttypes.GlobalSingleParameterIdentity(names.AttrName),
+		"aws_s3_bucket": inttypes.RegionalSingleParameterIdentity(names.AttrBucket),
+		"aws_sns_topic": inttypes.RegionalARNIdentity(),
+	}
+
+	expected := `import {
+  to = aws_example_thing.r_123_abc
+  id = "123$${abc}"
+}
+
+import {
+  to       = aws_iam_role.example_role
+  identity = {
+    name = "Example.Role"
+  }
+}
+
+import {
+  to = aws_instance.web
+  id = "i-0123456789abcdef0"
+}
+
+import {
+  to = aws_instance.web_2
+  id = "i-0123456789abcdef1"
+}
+
+import {
+  to       = aws_s3_bucket.example-bucket
+  identity = {
+    bucket = "example-bucket"
+    region = "us-west-2"
+  }
+}
+
+import {
+  to       = aws_sns_topic.arn_aws_sns_us-west-2_123456789012_example
+  identity = {
+    arn = "arn:aws:sns:us-west-2:123456789012:example"
+  }
+}
+`
+
+	if got := string(r.ImportConfig(identities)); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestMatchesFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		filter   string
+		expected bool
+	}{
+		"empty": {
+			name:     "aws_instance",
+			expected: true,
+		},
+		"match": {
+			name:     "aws_instance",
+			filter:   "aws_vpc,INSTANCE",
+			expected: true,
+		},
+		"no match": {
+			name:   "aws_instance",
+			filter: "aws_vpc,aws_subnet",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := matchesFilter(testCase.name, testCase.filter); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestRegistryRunSelected(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var ran []string
+	sweeper := func(name string, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(string) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				ran = append(ran, name)
+
+				return nil
+			},
+		}
+	}
+
+	r := newRegistry()
+	for _, s := range []*resource.Sweeper{
+		sweeper("aws_vpc", "aws_subnet"),
+		sweeper("aws_subnet", "aws_instance"),
+		sweeper("aws_instance"),
+		sweeper("aws_s3_bucket"),
+	} {
+		if err := r.add(s.Name, s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// Dependencies are not run.
+	if err := r.runSelected([]string{"us-west-2"}, "aws_vpc,aws_instance", false, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	slices.Sort(ran)
+	if expected := []string{"aws_instance", "aws_vpc"}; !slices.Equal(ran, expected) {
+		t.Errorf("expected sweepers %v to run, got %v", expected, ran)
+	}
+
+	if err := r.runSelected([]string{"us-west-2"}, "aws_sqs_queue", false, 2); err == nil {
+		t.Error("expected error when no sweepers match")
+	}
+}
+
+func TestImportResources(t *testing.T) {
+	t.Parallel()
+
+	// Global resources are listed in every Region.
+	resources := []ResourceDescription{
+		{Type: "aws_iam_role", ID: "admin", Name: "admin", Region: "us-west-2"},
+		{Type: "aws_s3_bucket", ID: "logs", Name: "logs", Region: "us-west-2"},
+		{Type: "aws_iam_role", ID: "admin", Name: "admin", Region: "us-east-1"},
+		{Type: "aws_s3_bucket", ID: "logs", Name: "logs", Region: "us-east-1"},
+	}
+	globalResourceTypes := map[string]bool{
+		"aws_iam_role":  true,
+		"aws_s3_bucket": false,
+	}
+
+	expected := []ResourceDescription{
+		{Type: "aws_iam_role", ID: "admin", Name: "admin"},
+		{Type: "aws_s3_bucket", ID: "logs", Name: "logs", Region: "us-west-2"},
+		{Type: "aws_s3_bucket", ID: "logs", Name: "logs", Region: "us-east-1"},
+	}
+
+	if diff := cmp.Diff(expected, importResources(resources, globalResourceTypes).Resources()); diff != "" {
+		t.Errorf("unexpected resources (-want +got):\n%s", diff)
+	}
+}
@@ -31,6 +31,17 @@ func Logger(ctx context.Context, loggerName, region string) context.Context {
 	return ctx
 }
 
+type resourceTypeKey struct{}
+
 func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = context.WithValue(ctx, resourceTypeKey{}, resourceType)
+
 	return tflog.SetField(ctx, loggingKeyResourceType, resourceType)
 }
+
+// ResourceType returns the resource type set by WithResourceType, if any.
+func ResourceType(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeKey{}).(string)
+
+	return v
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var invalidLabelCharacters = regexache.MustCompile(`[^a-z0-9_-]+`)
+
+// ImportConfig returns Terraform import blocks for the reported resources.
+// Resources are imported by identity where the resource type's identity (keyed by type name) can be derived
+// from the resource's ID, otherwise by ID.
+// Blocks are ordered by resource type and ID, and each resource's address is derived from its name, or if
+// it has no name, its ID, so that the same resources always result in the same configuration.
+func (r *Report) ImportConfig(identities map[string]inttypes.Identity) []byte {
+	resources := r.Resources()
+	slices.SortFunc(resources, func(a, b Resource) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID), cmp.Compare(a.Region, b.Region))
+	})
+
+	var buf bytes.Buffer
+	addresses := make(map[string]struct{})
+
+	for i, resource := range resources {
+		label := resourceLabel(resource)
+		for n := 2; ; n++ {
+			if _, ok := addresses[resource.Type+"."+label]; !ok {
+				break
+			}
+			label = resourceLabel(resource) + "_" + strconv.Itoa(n)
+		}
+		addresses[resource.Type+"."+label] = struct{}{}
+
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("import {\n")
+		if identity, ok := identityFromID(identities[resource.Type], resource); ok {
+			fmt.Fprintf(&buf, "  to       = %s.%s\n", resource.Type, label)
+			buf.WriteString("  identity = {\n")
+			keys := make([]string, 0, len(identity))
+			for k := range identity {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+			width := slices.MaxFunc(keys, func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+			for _, k := range keys {
+				fmt.Fprintf(&buf, "    %-*s = %s\n", len(width), k, quote(identity[k]))
+			}
+			buf.WriteString("  }\n")
+		} else {
+			fmt.Fprintf(&buf, "  to = %s.%s\n", resource.Type, label)
+			fmt.Fprintf(&buf, "  id = %s\n", quote(resource.ID))
+		}
+		buf.WriteString("}\n")
+	}
+
+	return buf.Bytes()
+}
+
+// WriteImportConfig writes Terraform import blocks for the reported resources to the named file.
+func (r *Report) WriteImportConfig(name string, identities map[string]inttypes.Identity) error {
+	return os.WriteFile(name, r.ImportConfig(identities), 0644)
+}
+
+// quote returns a Terraform string literal with the specified value.
+func quote(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(strconv.Quote(s))
+}
+
+// resourceLabel returns a valid Terraform resource name for the specified resource.
+func resourceLabel(resource Resource) string {
+	label := resource.Name
+	if label == "" {
+		label = resource.ID
+	}
+
+	label = strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(label), "_"), "_-")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "r_" + label
+	}
+
+	return label
+}
+
+// identityFromID returns the identity attribute values of the specified resource if they can be derived from its ID.
+func identityFromID(identity inttypes.Identity, resource Resource) (map[string]string, bool) {
+	var name string
+
+	switch {
+	case identity.IsSingleton:
+	case identity.IsSingleParameter:
+		name = identity.IdentityAttribute
+	case identity.IsARN:
+		if !arn.IsARN(resource.ID) {
+			return nil, false
+		}
+		name = identity.IdentityAttribute
+	case identity.IDAttrShadowsAttr != "":
+		name = identity.IDAttrShadowsAttr
+	default:
+		return nil, false
+	}
+
+	result := make(map[string]string)
+	if name != "" {
+		result[name] = resource.ID
+	}
+	if resource.Region != "" && slices.ContainsFunc(identity.Attributes, func(v inttypes.IdentityAttribute) bool {
+		return v.Name == names.AttrRegion
+	}) {
+		result[names.AttrRegion] = resource.Region
+	}
+
+	if len(result) == 0 {
+		return nil, false
+	}
+
+	return result, true
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Resource describes a resource that is, or in dry run mode would be, swept.
+type Resource struct {
+	Type   string            `json:"type"`
+	ID     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	Region string            `json:"region"`
+	Tags   map[string]string `json:"tags,omitempty"`
+}
+
+// Report records swept resources. It is safe for concurrent use.
+type Report struct {
+	lock      sync.Mutex
+	dryRun    bool
+	resources []Resource
+}
+
+func New(dryRun bool) *Report {
+	return &Report{
+		dryRun:    dryRun,
+		resources: make([]Resource, 0),
+	}
+}
+
+func (r *Report) Add(resource Resource) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.resources = append(r.resources, resource)
+}
+
+func (r *Report) Resources() []Resource {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]Resource(nil), r.resources...)
+}
+
+func (r *Report) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		DryRun    bool       `json:"dry_run"`
+		Resources []Resource `json:"resources"`
+	}{
+		DryRun:    r.dryRun,
+		Resources: r.Resources(),
+	})
+}
+
+// Write writes the report as JSON to the named file.
+func (r *Report) Write(name string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, b, 0644)
+}
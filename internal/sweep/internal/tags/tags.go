@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+)
+
+// NewContext returns a Context in which a resource's Read handler records the tags returned from AWS.
+func NewContext(ctx context.Context, meta *conns.AWSClient) context.Context {
+	return tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
+}
+
+// FromContext returns the tags recorded in ctx, if any.
+func FromContext(ctx context.Context) (map[string]string, bool) {
+	inContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	if inContext.TagsOut.IsNone() {
+		return nil, false
+	}
+
+	return inContext.TagsOut.MustUnwrap().Map(), true
+}
+
+// List lists the tags of a resource of the type being swept, as set by log.WithResourceType, recording them in ctx.
+// Resources that use transparent tagging do not set tags in their Read handler; the tags are listed by an interceptor
+// that is not run when sweeping.
+// identifier returns the resource's identifier for the service's tagging API given the resource type's tagging information.
+// List returns false if the resource type is unknown or does not use transparent tagging.
+func List(ctx context.Context, meta *conns.AWSClient, identifier func(interceptors.HTags) string) (bool, error) {
+	resourceType := log.ResourceType(ctx)
+	if resourceType == "" {
+		return false, nil
+	}
+
+	for sp := range meta.ServicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == resourceType {
+				if tfunique.IsHandleNil(v.Tags) {
+					return false, nil
+				}
+				return true, list(ctx, meta, sp, interceptors.HTags(v.Tags), identifier)
+			}
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.TypeName == resourceType {
+				if tfunique.IsHandleNil(v.Tags) {
+					return false, nil
+				}
+				return true, list(ctx, meta, sp, interceptors.HTags(v.Tags), identifier)
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func list(ctx context.Context, meta *conns.AWSClient, sp conns.ServicePackage, h interceptors.HTags, identifier func(interceptors.HTags) string) error {
+	v := identifier(h)
+	if v == "" {
+		return nil
+	}
+
+	return h.ListTags(ctx, sp, meta, v)
+}
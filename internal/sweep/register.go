@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+)
+
+// registry records registered sweepers and the dependencies between them.
+type registry struct {
+	lock     sync.Mutex
+	graph    *depgraph.Graph
+	sweepers map[string]*resource.Sweeper
+}
+
+func newRegistry() *registry {
+	return &registry{
+		graph:    depgraph.New(),
+		sweepers: make(map[string]*resource.Sweeper),
+	}
+}
+
+// sweepers is the registry of all sweepers added via AddTestSweepers.
+var sweepers = newRegistry()
+
+// AddTestSweepers registers a sweeper to be run when the -sweep flag is used with `go test`.
+// The sweeper runs after all the sweepers listed in its Dependencies.
+// Registering a sweeper whose dependencies form a cycle is a fatal error.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if err := sweepers.add(name, s); err != nil {
+		log.Fatalf("[ERR] Error adding sweeper (%s): %s", name, err)
+	}
+
+	resource.AddTestSweepers(name, s)
+}
+
+func (r *registry) add(name string, s *resource.Sweeper) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.sweepers[name]; ok {
+		return fmt.Errorf("sweeper already registered")
+	}
+
+	// Dependencies may not have been registered yet.
+	r.graph.AddNode(name)
+	for _, dependency := range s.Dependencies {
+		r.graph.AddNode(dependency)
+		if err := r.graph.AddDependency(name, dependency); err != nil {
+			return err
+		}
+	}
+
+	// Any new cycle must pass through the sweeper being added.
+	if _, err := r.graph.DependenciesOf(name); err != nil {
+		for _, dependency := range s.Dependencies {
+			r.graph.RemoveDependency(name, dependency)
+		}
+
+		return err
+	}
+
+	r.sweepers[name] = s
+
+	return nil
+}
+
+// LogMissingDependencies logs each sweeper dependency that has not been registered as a sweeper.
+// Such dependencies are skipped when sweepers are run.
+func LogMissingDependencies() {
+	for _, v := range sweepers.missingDependencies() {
+		log.Printf("[ERROR] Sweeper (%s) has dependency (%s), but that sweeper was not found", v.name, v.dependency)
+	}
+}
+
+type missingDependency struct {
+	name, dependency string
+}
+
+// missingDependencies returns the dependencies of registered sweepers that have not themselves been registered.
+func (r *registry) missingDependencies() []missingDependency {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var missing []missingDependency
+	for _, name := range slices.Sorted(maps.Keys(r.sweepers)) {
+		for _, dependency := range r.sweepers[name].Dependencies {
+			if _, ok := r.sweepers[dependency]; !ok {
+				missing = append(missing, missingDependency{name: name, dependency: dependency})
+			}
+		}
+	}
+
+	return missing
+}
@@ -180,6 +180,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func registerSweepers() {
@@ -360,4 +361,6 @@ func registerSweepers() {
 	wafv2.RegisterSweepers()
 	workspaces.RegisterSweepers()
 	xray.RegisterSweepers()
+
+	sweep.LogMissingDependencies()
 }
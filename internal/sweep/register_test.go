@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRegistryAddCycle(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+
+	if err := r.add("a", &resource.Sweeper{Dependencies: []string{"b"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := r.add("b", &resource.Sweeper{Dependencies: []string{"c"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := r.add("c", &resource.Sweeper{Dependencies: []string{"a"}}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if err := r.add("a", &resource.Sweeper{}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	// The rejected sweeper's dependencies must not remain in the graph.
+	if err := r.add("c", &resource.Sweeper{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRegistryFilter(t *testing.T) {
+	t.Parallel()
+
+	r := newRegistry()
+	for name, dependencies := range map[string][]string{
+		"aws_example_thing":       {"aws_example_child"},
+		"aws_example_child":       nil,
+		"aws_other_thing":         nil,
+		"aws_another_other_thing": {"aws_other_thing"},
+	} {
+		if err := r.add(name, &resource.Sweeper{Dependencies: dependencies}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"all": {
+			filter:   "",
+			expected: []string{"aws_another_other_thing", "aws_example_child", "aws_example_thing", "aws_other_thing"},
+		},
+		"with dependencies": {
+			filter:   "aws_example_thing",
+			expected: []string{"aws_example_child", "aws_example_thing"},
+		},
+		"fuzzy": {
+			filter:   "Other",
+			expected: []string{"aws_another_other_thing", "aws_other_thing"},
+		},
+		"multiple": {
+			filter:   "aws_example_child,aws_other_thing",
+			expected: []string{"aws_example_child", "aws_other_thing"},
+		},
+		"none": {
+			filter: "aws_nonexistent",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := r.filter(testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			slices.Sort(got)
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestRegistryRunOrder(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var ran []string
+	sweeper := func(name string, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(string) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				ran = append(ran, name)
+
+				return nil
+			},
+		}
+	}
+
+	r := newRegistry()
+	for _, s := range []*resource.Sweeper{
+		sweeper("vpc", "subnet", "security_group"),
+		sweeper("subnet", "instance"),
+		sweeper("security_group", "instance"),
+		sweeper("instance"),
+		sweeper("bucket"),
+	} {
+		if err := r.add(s.Name, s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := r.run([]string{"us-west-2"}, "", false, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(ran), 5; got != expected {
+		t.Fatalf("expected %d sweepers to run, got %d", expected, got)
+	}
+
+	before := func(a, b string) bool {
+		return slices.Index(ran, a) < slices.Index(ran, b)
+	}
+	for _, v := range [][2]string{
+		{"instance", "subnet"},
+		{"instance", "security_group"},
+		{"subnet", "vpc"},
+		{"security_group", "vpc"},
+	} {
+		if !before(v[0], v[1]) {
+			t.Errorf("expected %s to run before %s, got %v", v[0], v[1], ran)
+		}
+	}
+}
+
+func TestRegistryRunFailedDependency(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowFailures bool
+		expectedRan   []string
+	}{
+		"failures not allowed": {
+			expectedRan: []string{"child", "unrelated"},
+		},
+		"failures allowed": {
+			allowFailures: true,
+			expectedRan:   []string{"child", "parent", "unrelated"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var ran []string
+			sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+				return &resource.Sweeper{
+					Name:         name,
+					Dependencies: dependencies,
+					F: func(string) error {
+						lock.Lock()
+						defer lock.Unlock()
+
+						ran = append(ran, name)
+
+						return err
+					},
+				}
+			}
+
+			r := newRegistry()
+			for _, s := range []*resource.Sweeper{
+				sweeper("parent", nil, "child"),
+				sweeper("child", errors.New("failed")),
+				sweeper("unrelated", nil),
+			} {
+				if err := r.add(s.Name, s); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if err := r.run([]string{"us-west-2"}, "", testCase.allowFailures, 10); err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			slices.Sort(ran)
+			if !slices.Equal(ran, testCase.expectedRan) {
+				t.Errorf("expected %v to run, got %v", testCase.expectedRan, ran)
+			}
+		})
+	}
+}
+
+func TestRegistryRunMissingDependency(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var ran []string
+	r := newRegistry()
+	for _, name := range []string{"parent", "unrelated"} {
+		s := &resource.Sweeper{
+			Name: name,
+			F: func(string) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				ran = append(ran, name)
+
+				return nil
+			},
+		}
+		if name == "parent" {
+			s.Dependencies = []string{"missing"}
+		}
+		if err := r.add(s.Name, s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := r.missingDependencies(), []missingDependency{{name: "parent", dependency: "missing"}}; !slices.Equal(got, want) {
+		t.Errorf("missing dependencies = %v, want %v", got, want)
+	}
+
+	if err := r.run([]string{"us-west-2"}, "", false, 10); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	slices.Sort(ran)
+	if want := []string{"parent", "unrelated"}; !slices.Equal(ran, want) {
+		t.Errorf("expected %v to run, got %v", want, ran)
+	}
+}
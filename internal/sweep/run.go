@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+)
+
+// The -sweep, -sweep-allow-failures and -sweep-run flags are defined by resource.TestMain.
+var (
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of Sweepers to run concurrently")
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources that would be swept, without deleting them")
+	flagSweepReport      = flag.String("sweep-report", "", "Path of the JSON report of swept resources (default \""+defaultReportPath+"\" in dry run mode)")
+	flagSweepNamePrefix  = flag.String("sweep-name-prefix", "", "Comma-separated list of name prefixes; only resources whose names start with one of them are swept")
+	flagSweepTags        = flag.String("sweep-tags", "", "Comma-separated list of key=value tags; only resources with all of them are swept")
+	flagSweepImport      = flag.String("sweep-import-config", "", "Path of a Terraform configuration file of import blocks for the existing resources listed by the sweepers selected by -sweep-run, which are run in dry run mode")
+)
+
+const defaultReportPath = "sweep-report.json"
+
+// TestMain adds sweeper functionality to the "go test" command, otherwise tests are executed as normal.
+// It accepts the same flags as resource.TestMain, but runs sweepers in dependency order with
+// sweepers that do not depend on each other running concurrently, up to the -sweep-parallelism limit.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	filter := flag.Lookup("sweep-run").Value.String()
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.(flag.Getter).Get().(bool)
+
+	tags, err := parseTags(*flagSweepTags)
+	if err != nil {
+		log.Printf("[ERROR] -sweep-tags: %s", err)
+		os.Exit(1)
+	}
+
+	options = sweepOptions{
+		dryRun: *flagSweepDryRun,
+		tags:   tags,
+	}
+	if v := *flagSweepNamePrefix; v != "" {
+		options.namePrefixes = strings.Split(v, ",")
+	}
+
+	reportPath := *flagSweepReport
+
+	if importConfigPath := *flagSweepImport; importConfigPath != "" {
+		importConfig(strings.Split(regions, ","), filter, allowFailures, *flagSweepParallelism, importConfigPath, reportPath)
+		return
+	}
+
+	if reportPath == "" && *flagSweepDryRun {
+		reportPath = defaultReportPath
+	}
+	if reportPath != "" {
+		options.report = report.New(options.dryRun)
+	}
+
+	if options.dryRun {
+		log.Printf("[INFO] Running Sweepers in dry run mode, no resources will be deleted")
+	}
+
+	err = sweepers.run(strings.Split(regions, ","), filter, allowFailures, *flagSweepParallelism)
+
+	if reportPath != "" {
+		if err := options.report.Write(reportPath); err != nil {
+			log.Printf("[ERROR] Writing sweep report (%s): %s", reportPath, err)
+			os.Exit(1)
+		}
+		log.Printf("[INFO] Wrote sweep report (%s)", reportPath)
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// importConfig writes import blocks for the existing resources of the resource types selected by filter,
+// and optionally a report of them. The selected sweepers, but not their dependencies, are run in dry run mode
+// to list the resources, so no resources are changed.
+func importConfig(regions []string, filter string, allowFailures bool, parallelism int, importConfigPath, reportPath string) {
+	log.Printf("[INFO] Running Sweepers in dry run mode to list resources for import configuration, no resources will be deleted")
+
+	options.dryRun = true
+	options.report = report.New(true)
+
+	err := sweepers.runSelected(regions, filter, allowFailures, parallelism)
+
+	ctx := context.Background()
+	resources := importResources(options.report.Resources(), globalResourceTypes(ctx))
+
+	if reportPath != "" {
+		if err := resources.Write(reportPath); err != nil {
+			log.Printf("[ERROR] Writing sweep report (%s): %s", reportPath, err)
+			os.Exit(1)
+		}
+		log.Printf("[INFO] Wrote sweep report (%s)", reportPath)
+	}
+
+	if err := resources.WriteImportConfig(importConfigPath, resourceIdentities(ctx)); err != nil {
+		log.Printf("[ERROR] Writing import configuration (%s): %s", importConfigPath, err)
+		os.Exit(1)
+	}
+	log.Printf("[INFO] Wrote import configuration (%s)", importConfigPath)
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// importResources returns a report of the specified resources for import configuration.
+// Sweepers for global resource types list the same resources in every Region, so those resources are
+// reported once, without a Region.
+func importResources(resources []ResourceDescription, globalResourceTypes map[string]bool) *report.Report {
+	type key struct {
+		typeName, id, region string
+	}
+	seen := make(map[key]struct{})
+	result := report.New(true)
+
+	for _, resource := range resources {
+		if globalResourceTypes[resource.Type] {
+			resource.Region = ""
+		}
+
+		k := key{typeName: resource.Type, id: resource.ID, region: resource.Region}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		result.Add(resource)
+	}
+
+	return result
+}
+
+// parseTags parses a comma-separated list of key=value tags.
+func parseTags(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+	for _, v := range strings.Split(s, ",") {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid tag (%s), expected key=value", v)
+		}
+		tags[key] = value
+	}
+
+	return tags, nil
+}
+
+// filter returns the names of the sweepers matching a comma-separated list of sweeper names, and their dependencies,
+// in the order in which they are to be run.
+// Names match if they contain any element in the list. An empty list matches all sweepers.
+// Dependencies which have not been registered as sweepers are omitted.
+func (r *registry) filter(f string) ([]string, error) {
+	order, err := r.graph.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	order = slices.DeleteFunc(order, func(name string) bool {
+		_, ok := r.sweepers[name]
+		return !ok
+	})
+
+	if f == "" {
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+	for name := range r.sweepers {
+		if matchesFilter(name, f) {
+			dependencies, err := r.graph.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			selected[name] = struct{}{}
+			for _, dependency := range dependencies {
+				selected[dependency] = struct{}{}
+			}
+		}
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		_, ok := selected[name]
+		return !ok
+	}), nil
+}
+
+// matchesFilter returns whether the specified name contains any element of a comma-separated list of sweeper names.
+// An empty list matches all names.
+func matchesFilter(name, f string) bool {
+	if f == "" {
+		return true
+	}
+
+	for s := range strings.SplitSeq(strings.ToLower(f), ",") {
+		if strings.Contains(strings.ToLower(name), s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *registry) run(regions []string, filter string, allowFailures bool, parallelism int) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	names, err := r.filter(filter)
+	if err != nil {
+		return err
+	}
+
+	return r.runNames(regions, names, allowFailures, parallelism)
+}
+
+// runSelected runs the sweepers matching filter, but not their dependencies.
+func (r *registry) runSelected(regions []string, filter string, allowFailures bool, parallelism int) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	names, err := r.filter(filter)
+	if err != nil {
+		return err
+	}
+
+	names = slices.DeleteFunc(names, func(name string) bool {
+		return !matchesFilter(name, filter)
+	})
+	if len(names) == 0 {
+		return fmt.Errorf("no sweepers match (%s)", filter)
+	}
+
+	return r.runNames(regions, names, allowFailures, parallelism)
+}
+
+// runNames runs the named sweepers, which are in the order in which they are to be run, in each of the specified Regions.
+func (r *registry) runNames(regions []string, names []string, allowFailures bool, parallelism int) error {
+	var errs []error
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		results := r.runRegion(region, names, allowFailures, parallelism)
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		var failed []string
+		log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+		for _, name := range names {
+			if err := results[name]; err == nil {
+				log.Printf("\t- %s\n", name)
+			} else {
+				failed = append(failed, name)
+			}
+		}
+
+		if len(failed) > 0 {
+			log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+			for _, name := range failed {
+				log.Printf("\t- %s: %s\n", name, results[name])
+			}
+
+			err := fmt.Errorf("%d sweepers failed for region (%s)", len(failed), region)
+			if !allowFailures {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// runRegion runs the named sweepers in the specified region, returning each sweeper's result.
+// A sweeper is started once all of its dependencies have completed. Unless failures are allowed,
+// a sweeper is not run if any of its dependencies failed.
+func (r *registry) runRegion(region string, names []string, allowFailures bool, parallelism int) map[string]error {
+	type result struct {
+		name string
+		err  error
+	}
+
+	selected := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		selected[name] = struct{}{}
+	}
+
+	// Number of incomplete dependencies of each sweeper.
+	// As with resource.TestMain, a dependency that was not registered does not prevent its dependents from running.
+	waiting := make(map[string]int, len(names))
+	for _, name := range names {
+		dependencies, _ := r.graph.DirectDependenciesOf(name)
+		for _, dependency := range dependencies {
+			if _, ok := selected[dependency]; ok {
+				waiting[name]++
+			}
+		}
+	}
+
+	results := make(map[string]error, len(names))
+	ready := make([]string, 0, len(names))
+	var complete func(name string, err error)
+	complete = func(name string, err error) {
+		results[name] = err
+
+		dependents, _ := r.graph.DirectDependentsOf(name)
+		for _, dependent := range dependents {
+			if _, ok := selected[dependent]; !ok {
+				continue
+			}
+
+			waiting[dependent]--
+			if waiting[dependent] > 0 {
+				continue
+			}
+
+			if !allowFailures {
+				if dependency, failed := r.failedDependency(dependent, results); failed {
+					complete(dependent, fmt.Errorf("dependency (%s) failed", dependency))
+					continue
+				}
+			}
+
+			ready = append(ready, dependent)
+		}
+	}
+
+	for _, name := range names {
+		if waiting[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	done := make(chan result)
+	running := 0
+
+	for len(results) < len(names) {
+		for running < max(parallelism, 1) && len(ready) > 0 {
+			name := ready[0]
+			ready = ready[1:]
+
+			sweeper := r.sweepers[name]
+
+			running++
+			go func() {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+				start := time.Now()
+				err := sweeper.F(region)
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+				if err != nil {
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+				}
+
+				done <- result{name: name, err: err}
+			}()
+		}
+
+		if running == 0 {
+			// Nothing left that can be run.
+			break
+		}
+
+		v := <-done
+		running--
+		complete(v.name, v.err)
+	}
+
+	return results
+}
+
+// failedDependency returns the first of the named sweeper's direct dependencies that failed.
+func (r *registry) failedDependency(name string, results map[string]error) (string, bool) {
+	dependencies, _ := r.graph.DirectDependenciesOf(name)
+	for _, dependency := range dependencies {
+		if results[dependency] != nil {
+			return dependency, true
+		}
+	}
+
+	return "", false
+}
@@ -11,7 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
+	sweeptags "github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -33,6 +38,82 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// Describe describes the resource.
+// Sweepers typically only set the resource's ID, so if the resource has a name or tags that are not set
+// the resource is read to determine them. sr's own ResourceData is not changed.
+func (sr *sweepResource) Describe(ctx context.Context) report.Resource {
+	r := report.Resource{
+		ID:     sr.d.Id(),
+		Region: sr.meta.Region(ctx),
+	}
+
+	schema := sr.resource.SchemaMap()
+	_, hasName := schema[names.AttrName]
+	_, hasTags := schema[names.AttrTags]
+
+	d := sr.d
+	if (hasName && nameOf(d) == "") || (hasTags && len(tagsOf(d)) == 0) {
+		ctx = sweeptags.NewContext(tflog.SetField(ctx, "id", sr.d.Id()), sr.meta)
+
+		d = sr.resource.Data(sr.d.State())
+		if err := ReadResource(ctx, sr.resource, d, sr.meta); err != nil {
+			tflog.Warn(ctx, "Reading resource to describe it", map[string]any{
+				"error": err.Error(),
+			})
+			d = sr.d
+		} else if d.Id() == "" {
+			// The resource no longer exists.
+			d = sr.d
+		}
+	}
+
+	// Values set by the sweeper take precedence over those read.
+	if hasName {
+		if r.Name = nameOf(sr.d); r.Name == "" {
+			r.Name = nameOf(d)
+		}
+	}
+	if hasTags {
+		if r.Tags = tagsOf(sr.d); len(r.Tags) == 0 {
+			r.Tags = tagsOf(d)
+		}
+
+		// Most Read handlers record tags in Context rather than setting them.
+		if len(r.Tags) == 0 && d != sr.d {
+			v, ok := sweeptags.FromContext(ctx)
+			if !ok {
+				if _, err := sweeptags.List(ctx, sr.meta, func(h interceptors.HTags) string {
+					return h.GetIdentifierSDKv2(ctx, d)
+				}); err != nil {
+					tflog.Warn(ctx, "Listing resource tags to describe it", map[string]any{
+						"error": err.Error(),
+					})
+				}
+				v, _ = sweeptags.FromContext(ctx)
+			}
+			if len(v) > 0 {
+				r.Tags = v
+			}
+		}
+	}
+
+	return r
+}
+
+func nameOf(d *schema.ResourceData) string {
+	v, _ := d.Get(names.AttrName).(string)
+
+	return v
+}
+
+func tagsOf(d *schema.ResourceData) map[string]string {
+	if v, ok := d.Get(names.AttrTags).(map[string]any); ok && len(v) > 0 {
+		return flex.ExpandStringValueMap(v)
+	}
+
+	return nil
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSweepResourceDescribe(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		read         schema.ReadContextFunc
+		setName      string
+		expectedName string
+		expectedTags map[string]string
+	}{
+		"read sets name and tags": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.Set(names.AttrName, "tf-acc-test-"+d.Id())
+				d.Set(names.AttrTags, map[string]any{"Team": "a"})
+				return nil
+			},
+			expectedName: "tf-acc-test-id-1",
+			expectedTags: map[string]string{"Team": "a"},
+		},
+		"read records tags in context": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.Set(names.AttrName, "tf-acc-test-"+d.Id())
+				if inContext, ok := tftags.FromContext(ctx); ok {
+					inContext.TagsOut = option.Some(tftags.New(ctx, map[string]string{"Team": "b"}))
+				}
+				return nil
+			},
+			expectedName: "tf-acc-test-id-1",
+			expectedTags: map[string]string{"Team": "b"},
+		},
+		"name already set": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.Set(names.AttrName, "from-read")
+				return nil
+			},
+			setName:      "from-sweeper",
+			expectedName: "from-sweeper",
+		},
+		"resource no longer exists": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.SetId("")
+				return nil
+			},
+		},
+		"read error": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				return diag.Errorf("reading")
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test", "us-west-2", "")
+
+			r := &schema.Resource{
+				ReadWithoutTimeout: testCase.read,
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			}
+			d := r.Data(nil)
+			d.SetId("id-1")
+			if testCase.setName != "" {
+				d.Set(names.AttrName, testCase.setName)
+			}
+
+			got := sdk.NewSweepResource(r, d, new(conns.AWSClient)).Describe(ctx)
+
+			if got, expected := got.ID, "id-1"; got != expected {
+				t.Errorf("expected ID %q, got %q", expected, got)
+			}
+			if got, expected := got.Region, "us-west-2"; got != expected {
+				t.Errorf("expected Region %q, got %q", expected, got)
+			}
+			if got, expected := got.Name, testCase.expectedName; got != expected {
+				t.Errorf("expected name %q, got %q", expected, got)
+			}
+			if !maps.Equal(got.Tags, testCase.expectedTags) {
+				t.Errorf("expected tags %v, got %v", testCase.expectedTags, got.Tags)
+			}
+
+			// Describing the resource must not change the sweeper's ResourceData.
+			if got, expected := d.Id(), "id-1"; got != expected {
+				t.Errorf("expected ResourceData ID %q, got %q", expected, got)
+			}
+			if got, expected := d.Get(names.AttrName).(string), testCase.setName; got != expected {
+				t.Errorf("expected ResourceData name %q, got %q", expected, got)
+			}
+		})
+	}
+}
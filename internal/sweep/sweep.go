@@ -7,7 +7,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -15,7 +18,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/report"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 )
 
 const (
@@ -27,16 +34,77 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
+// resourceIdentities returns the resource identity of each resource type, keyed by type name.
+func resourceIdentities(ctx context.Context) map[string]inttypes.Identity {
+	identities := make(map[string]inttypes.Identity)
+
+	for _, sp := range ServicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			identities[v.TypeName] = v.Identity
+		}
+		for _, v := range sp.SDKResources(ctx) {
+			identities[v.TypeName] = v.Identity
+		}
+	}
+
+	return identities
+}
+
+// globalResourceTypes returns the type names of global resource types, which do not support per-resource Region override.
+func globalResourceTypes(ctx context.Context) map[string]bool {
+	types := make(map[string]bool)
+
+	for _, sp := range ServicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			types[v.TypeName] = !tfunique.IsHandleNil(v.Region) && !v.Region.Value().IsOverrideEnabled
+		}
+		for _, v := range sp.SDKResources(ctx) {
+			types[v.TypeName] = !tfunique.IsHandleNil(v.Region) && !v.Region.Value().IsOverrideEnabled
+		}
+	}
+
+	return types
+}
+
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var sweeperClients clientCache
 
-// SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
-func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
+// clientCache is a cache of regional conns.AWSClient that is safe for use by concurrently running sweepers.
+type clientCache struct {
+	lock    sync.Mutex
+	clients map[string]*conns.AWSClient
+}
+
+// get returns the cached client for the specified Region, creating it with newClient on first use.
+// The lock is held while the client is created so that concurrent sweepers in the same Region share a single client.
+func (c *clientCache) get(ctx context.Context, region string, newClient func(context.Context, string) (*conns.AWSClient, error)) (*conns.AWSClient, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if client, ok := c.clients[region]; ok {
 		return client, nil
 	}
 
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.clients == nil {
+		c.clients = make(map[string]*conns.AWSClient)
+	}
+	c.clients[region] = client
+
+	return client, nil
+}
+
+// SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	return sweeperClients.get(ctx, region, newRegionalSweepClient)
+}
+
+func newRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
 	_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -95,8 +163,6 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -104,14 +170,116 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// ResourceDescription describes a resource that is, or in dry run mode would be, swept.
+type ResourceDescription = report.Resource
+
+// Describer is implemented by Sweepables that can describe the resource they sweep.
+// Only Sweepables that implement Describer are swept when filtering by name prefix or tag,
+// or are included in the sweep report.
+type Describer interface {
+	Describe(ctx context.Context) ResourceDescription
+}
+
+// sweepOptions control how SweepOrchestrator sweeps resources.
+type sweepOptions struct {
+	dryRun       bool
+	namePrefixes []string
+	tags         map[string]string
+	report       *report.Report
+}
+
+// options are set in TestMain.
+var options sweepOptions
+
+// DescribedOnly returns whether only resources that can be described may be swept,
+// which is the case in dry run mode or when filtering by name prefix or tag.
+// Sweepers that change resources without using SweepOrchestrator cannot describe them
+// and must not change anything when DescribedOnly returns true.
+func DescribedOnly() bool {
+	return options.describedOnly()
+}
+
+func (o *sweepOptions) describedOnly() bool {
+	return o.dryRun || o.filtered()
+}
+
+func (o *sweepOptions) filtered() bool {
+	return len(o.namePrefixes) > 0 || len(o.tags) > 0
+}
+
+// matches returns whether the described resource matches any name prefix and all tags.
+// A resource's ID is matched against the name prefixes if it has no name.
+func (o *sweepOptions) matches(r ResourceDescription) bool {
+	if len(o.namePrefixes) > 0 {
+		name := r.Name
+		if name == "" {
+			name = r.ID
+		}
+
+		if !slices.ContainsFunc(o.namePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		}) {
+			return false
+		}
+	}
+
+	for k, v := range o.tags {
+		if value, ok := r.Tags[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	return options.sweep(ctx, sweepables, optFns...)
+}
+
+func (o *sweepOptions) sweep(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
 	var g multierror.Group
 
+	// Describing a resource may require reading it, so resources are only described when necessary.
+	describe := o.describedOnly() || o.report != nil
+
 	for _, sweepable := range sweepables {
+		var description ResourceDescription
+		v, describable := sweepable.(Describer)
+		described := describable && describe
+		if described {
+			description = v.Describe(ctx)
+			if description.Type == "" {
+				description.Type = log.ResourceType(ctx)
+			}
+
+			if !o.matches(description) {
+				tflog.Debug(ctx, "Skipping resource not matching filters", map[string]any{
+					"id": description.ID,
+				})
+				continue
+			}
+		} else if o.describedOnly() {
+			tflog.Warn(ctx, "Skipping resource that cannot be described", map[string]any{
+				"sweepable": fmt.Sprintf("%T", sweepable),
+			})
+			continue
+		}
+
+		if described && o.report != nil {
+			o.report.Add(description)
+		}
+
+		if o.dryRun {
+			tflog.Info(ctx, "Would sweep resource", map[string]any{
+				"id": description.ID,
+			})
+			continue
+		}
+
 		g.Go(func() error {
 			return sweepable.Delete(ctx, optFns...)
 		})
@@ -120,4 +288,41 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 	return g.Wait().ErrorOrNil()
 }
 
+// WithPreDelete returns a Sweepable that calls f before deleting the resource swept by sweepable.
+// Changes that are needed before a resource can be deleted, e.g. disabling deletion protection,
+// must be made in f so that they are only made to resources that are actually swept.
+func WithPreDelete(sweepable Sweepable, f func(context.Context) error) Sweepable {
+	v := preDeleteSweepable{
+		sweepable: sweepable,
+		preDelete: f,
+	}
+
+	if describer, ok := sweepable.(Describer); ok {
+		return describablePreDeleteSweepable{
+			preDeleteSweepable: v,
+			Describer:          describer,
+		}
+	}
+
+	return v
+}
+
+type preDeleteSweepable struct {
+	sweepable Sweepable
+	preDelete func(context.Context) error
+}
+
+func (s preDeleteSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	if err := s.preDelete(ctx); err != nil {
+		return err
+	}
+
+	return s.sweepable.Delete(ctx, optFns...)
+}
+
+type describablePreDeleteSweepable struct {
+	preDeleteSweepable
+	Describer
+}
+
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// TestClientCacheParallelSweepers runs sweepers concurrently, as -sweep-parallelism does, each of which
+// gets its Region's client from the same cache. Run with -race to detect unsynchronized access.
+func TestClientCacheParallelSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var cache clientCache
+	var created atomic.Int32
+	newClient := func(context.Context, string) (*conns.AWSClient, error) {
+		created.Add(1)
+		// Widen the window in which a concurrent sweeper could observe a partially initialized cache.
+		time.Sleep(10 * time.Millisecond)
+
+		return new(conns.AWSClient), nil
+	}
+
+	// Both sweepers must be running at the same time.
+	var started sync.WaitGroup
+	started.Add(2)
+
+	var lock sync.Mutex
+	var clients []*conns.AWSClient
+	sweeper := func(name string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				started.Done()
+				started.Wait()
+
+				client, err := cache.get(ctx, region, newClient)
+				if err != nil {
+					return err
+				}
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				clients = append(clients, client)
+
+				return nil
+			},
+		}
+	}
+
+	r := newRegistry()
+	for _, s := range []*resource.Sweeper{
+		sweeper("a"),
+		sweeper("b"),
+	} {
+		if err := r.add(s.Name, s); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := r.run([]string{"us-west-2"}, "", false, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := created.Load(), int32(1); got != expected {
+		t.Errorf("expected %d client to be created, got %d", expected, got)
+	}
+	if got, expected := len(clients), 2; got != expected {
+		t.Fatalf("expected %d clients, got %d", expected, got)
+	}
+	if clients[0] != clients[1] {
+		t.Error("expected sweepers to share a client")
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Constraints are the limits that a service places on a resource's tags.
+// Zero values are unconstrained.
+type Constraints struct {
+	MaxTags        int
+	MaxKeyLength   int // In Unicode characters.
+	MaxValueLength int // In Unicode characters.
+	// Whether tag keys and values are restricted to Unicode letters, digits, white space and _ . : / = + - @.
+	RestrictedCharacters bool
+}
+
+// Unicode letters, digits, white space and _ . : / = + - @.
+var restrictedCharactersPattern = regexache.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
+// DefaultConstraints returns the tagging limits common to most services.
+// Resource types whose service's limits differ override them in their @Tags annotation.
+// See https://docs.aws.amazon.com/tag-editor/latest/userguide/tagging.html#tag-conventions.
+func DefaultConstraints() Constraints {
+	return Constraints{
+		MaxTags:        50,
+		MaxKeyLength:   128,
+		MaxValueLength: 256,
+	}
+}
+
+// Violations returns a description of each way in which the given tags exceed the limits.
+// The aws: tag key prefix is reserved for use by AWS, so tag keys with that prefix are always violations.
+func (c Constraints) Violations(tags KeyValueTags) []string {
+	var violations []string
+
+	if c.MaxTags > 0 && len(tags) > c.MaxTags {
+		violations = append(violations, fmt.Sprintf("%d tags exceeds the maximum of %d", len(tags), c.MaxTags))
+	}
+
+	m := tags.Map()
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if strings.HasPrefix(strings.ToLower(k), awsTagKeyPrefix) {
+			violations = append(violations, fmt.Sprintf("tag key %q uses the %q prefix, which is reserved for use by AWS", k, awsTagKeyPrefix))
+		}
+		if c.MaxKeyLength > 0 && utf8.RuneCountInString(k) > c.MaxKeyLength {
+			violations = append(violations, fmt.Sprintf("tag key %q exceeds the maximum length of %d characters", k, c.MaxKeyLength))
+		}
+		if c.RestrictedCharacters && !restrictedCharactersPattern.MatchString(k) {
+			violations = append(violations, fmt.Sprintf("tag key %q contains characters that are not allowed", k))
+		}
+
+		v := m[k]
+		if c.MaxValueLength > 0 && utf8.RuneCountInString(v) > c.MaxValueLength {
+			violations = append(violations, fmt.Sprintf("tag %q value exceeds the maximum length of %d characters", k, c.MaxValueLength))
+		}
+		if c.RestrictedCharacters && !restrictedCharactersPattern.MatchString(v) {
+			violations = append(violations, fmt.Sprintf("tag %q value contains characters that are not allowed", k))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConstraintsViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	manyTags := func(n int) map[string]string {
+		m := make(map[string]string, n)
+		for i := range n {
+			m[fmt.Sprintf("key%d", i)] = "value"
+		}
+		return m
+	}
+
+	testCases := []struct {
+		name        string
+		constraints Constraints
+		tags        KeyValueTags
+		want        []string
+	}{
+		{
+			name:        "unconstrained",
+			constraints: Constraints{},
+			tags:        New(ctx, manyTags(100)),
+		},
+		{
+			name:        "default within limits",
+			constraints: DefaultConstraints(),
+			tags: New(ctx, map[string]string{
+				strings.Repeat("k", 128): strings.Repeat("v", 256),
+			}),
+		},
+		{
+			name:        "too many tags",
+			constraints: Constraints{MaxTags: 10},
+			tags:        New(ctx, manyTags(11)),
+			want: []string{
+				"11 tags exceeds the maximum of 10",
+			},
+		},
+		{
+			name:        "key and value too long",
+			constraints: Constraints{MaxKeyLength: 127, MaxValueLength: 255},
+			tags: New(ctx, map[string]string{
+				strings.Repeat("k", 128): "value",
+				"key1":                   strings.Repeat("v", 256),
+			}),
+			want: []string{
+				`tag "key1" value exceeds the maximum length of 255 characters`,
+				"tag key \"" + strings.Repeat("k", 128) + "\" exceeds the maximum length of 127 characters",
+			},
+		},
+		{
+			name:        "lengths counted in characters",
+			constraints: Constraints{MaxKeyLength: 3, MaxValueLength: 3},
+			tags: New(ctx, map[string]string{
+				"äöü": "ßßß",
+			}),
+		},
+		{
+			name:        "restricted characters",
+			constraints: Constraints{RestrictedCharacters: true},
+			tags: New(ctx, map[string]string{
+				"Cost Center": "1234",
+				"key#1":       "value",
+				"key2":        "value*",
+			}),
+			want: []string{
+				`tag key "key#1" contains characters that are not allowed`,
+				`tag "key2" value contains characters that are not allowed`,
+			},
+		},
+		{
+			name:        "reserved prefix",
+			constraints: DefaultConstraints(),
+			tags: New(ctx, map[string]string{
+				"AWS:Key":     "value",
+				"aws:key":     "value",
+				"key:aws:key": "value",
+				"owner":       "aws:value",
+			}),
+			want: []string{
+				`tag key "AWS:Key" uses the "aws:" prefix, which is reserved for use by AWS`,
+				`tag key "aws:key" uses the "aws:" prefix, which is reserved for use by AWS`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.constraints.Violations(testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -53,12 +54,20 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Resource type names, e.g. "aws_instance", that the tags are defaulted on. Empty means all resource types.
+	IncludeResourceTypes []string
+	// Resource type names that the tags are not defaulted on.
+	ExcludeResourceTypes []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// Tags whose key matches any of these patterns are removed.
+	KeyPatterns []*regexp.Regexp
+	// Tags whose value matches any of these patterns are removed.
+	ValuePatterns []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -76,6 +85,24 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResourceType returns the DefaultConfig that applies to the specified resource type,
+// or nil if no tags are defaulted on that resource type.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if len(dc.IncludeResourceTypes) > 0 && !slices.Contains(dc.IncludeResourceTypes, typeName) {
+		return nil
+	}
+
+	if slices.Contains(dc.ExcludeResourceTypes, typeName) {
+		return nil
+	}
+
+	return dc
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -127,6 +154,7 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnorePatterns(config.KeyPatterns, config.ValuePatterns)
 
 	return result
 }
@@ -178,6 +206,30 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnorePatterns returns tags whose key does not match any of the key patterns
+// and whose value does not match any of the value patterns.
+func (tags KeyValueTags) IgnorePatterns(keyPatterns, valuePatterns []*regexp.Regexp) KeyValueTags {
+	if len(keyPatterns) == 0 && len(valuePatterns) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(keyPatterns, func(re *regexp.Regexp) bool { return re.MatchString(k) }) {
+			continue
+		}
+
+		if value := v.ValueString(); slices.ContainsFunc(valuePatterns, func(re *regexp.Regexp) bool { return re.MatchString(value) }) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
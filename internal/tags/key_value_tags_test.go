@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"slices"
 	"testing"
 
@@ -57,6 +58,106 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          map[string]string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			typeName:      "aws_instance",
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "all resource types",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			typeName: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "included",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IncludeResourceTypes: []string{"aws_instance", "aws_vpc"},
+			},
+			typeName: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "not included",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IncludeResourceTypes: []string{"aws_vpc"},
+			},
+			typeName: "aws_instance",
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			typeName: "aws_autoscaling_group",
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "included and excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IncludeResourceTypes: []string{"aws_instance"},
+				ExcludeResourceTypes: []string{"aws_instance"},
+			},
+			typeName: "aws_instance",
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName).MergeTags(New(ctx, map[string]string{
+				"key2": "value2",
+			}))
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -565,6 +666,63 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key patterns",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+				"scan:2024-10-01:result":        "clean",
+				"key1":                          "value1",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`^scan:\d{4}-\d{2}-\d{2}:`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "value patterns",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "managed-by-scanner",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValuePatterns: []*regexp.Regexp{
+					regexp.MustCompile(`scanner`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys and patterns",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^key2$`),
+				},
+				ValuePatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^value3$`),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+type PolicyEnforcement string
+
+const (
+	// PolicyEnforcementError fails the plan of a resource whose tags violate the policy.
+	PolicyEnforcementError PolicyEnforcement = "error"
+	// PolicyEnforcementWarn warns about a resource whose tags violate the policy.
+	PolicyEnforcementWarn PolicyEnforcement = "warn"
+)
+
+func (PolicyEnforcement) Values() []PolicyEnforcement {
+	return []PolicyEnforcement{
+		PolicyEnforcementError,
+		PolicyEnforcementWarn,
+	}
+}
+
+// PolicyConfig contains the tagging policy that the tags of all resources must conform to.
+type PolicyConfig struct {
+	// Tag keys that must be present.
+	RequiredKeys []string
+	// Tag key -> pattern that the tag's value must match. See CompilePolicyPattern.
+	AllowedValues map[string]*regexp.Regexp
+	// Pattern that every tag key must match. See CompilePolicyPattern.
+	KeyPattern  *regexp.Regexp
+	Enforcement PolicyEnforcement
+}
+
+// CompilePolicyPattern compiles an RE2 regular expression that a tag key or value must wholly match.
+func CompilePolicyPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// WarnOnly returns whether policy violations are warnings rather than errors.
+func (pc *PolicyConfig) WarnOnly() bool {
+	return pc != nil && pc.Enforcement == PolicyEnforcementWarn
+}
+
+// Violations returns a description of each way in which the given tags violate the policy.
+// AWS system tags are not checked.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+	tags = tags.IgnoreAWS()
+
+	for _, k := range pc.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			violations = append(violations, fmt.Sprintf("required tag %q is missing", k))
+		}
+	}
+
+	m := tags.Map()
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if pc.KeyPattern != nil && !pc.KeyPattern.MatchString(k) {
+			violations = append(violations, fmt.Sprintf("tag key %q does not match pattern %q", k, pc.KeyPattern))
+		}
+
+		if re, ok := pc.AllowedValues[k]; ok {
+			if v := m[k]; !re.MatchString(v) {
+				violations = append(violations, fmt.Sprintf("tag %q value %q does not match allowed values %q", k, v, re))
+			}
+		}
+	}
+
+	return violations
+}
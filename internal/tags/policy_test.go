@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mustCompile := func(pattern string) *regexp.Regexp {
+		re, err := CompilePolicyPattern(pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return re
+	}
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "empty config",
+			policyConfig: &PolicyConfig{},
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "required keys present",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team",
+				"key1":       "value1",
+			}),
+		},
+		{
+			name: "required keys missing",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			tags: New(ctx, map[string]string{
+				"Owner": "team",
+			}),
+			want: []string{
+				`required tag "CostCenter" is missing`,
+			},
+		},
+		{
+			name: "allowed values",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string]*regexp.Regexp{
+					"Environment": mustCompile("dev|prod"),
+					"CostCenter":  mustCompile(`\d+`),
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "production",
+			}),
+			want: []string{
+				`tag "Environment" value "production" does not match allowed values "^(?:dev|prod)$"`,
+			},
+		},
+		{
+			name: "key pattern",
+			policyConfig: &PolicyConfig{
+				KeyPattern: mustCompile("[A-Z][A-Za-z]*"),
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter":   "1234",
+				"cost-center":  "1234",
+				"aws:internal": "ignored",
+			}),
+			want: []string{
+				`tag key "cost-center" does not match pattern "^(?:[A-Z][A-Za-z]*)$"`,
+			},
+		},
+		{
+			name: "multiple violations",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"Owner"},
+				AllowedValues: map[string]*regexp.Regexp{
+					"Environment": mustCompile("dev|prod"),
+				},
+				KeyPattern: mustCompile("[A-Z][A-Za-z]*"),
+			},
+			tags: New(ctx, map[string]string{
+				"Environment": "test",
+				"name":        "example",
+			}),
+			want: []string{
+				`required tag "Owner" is missing`,
+				`tag "Environment" value "test" does not match allowed values "^(?:dev|prod)$"`,
+				`tag key "name" does not match pattern "^(?:[A-Z][A-Za-z]*)$"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigWarnOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		want         bool
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			want:         false,
+		},
+		{
+			name:         "default enforcement",
+			policyConfig: &PolicyConfig{},
+			want:         false,
+		},
+		{
+			name:         "error",
+			policyConfig: &PolicyConfig{Enforcement: PolicyEnforcementError},
+			want:         false,
+		},
+		{
+			name:         "warn",
+			policyConfig: &PolicyConfig{Enforcement: PolicyEnforcementWarn},
+			want:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.policyConfig.WarnOnly(); got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -52,3 +52,20 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks overlap:
+// - Both CIDR blocks parse to an IP address and network
+// - One network contains the other
+// CIDR blocks of different address families never overlap.
+func CIDRBlocksOverlap(cidr1, cidr2 string) bool {
+	_, ipnet1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false
+	}
+	_, ipnet2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false
+	}
+
+	return ipnet1.Contains(ipnet2.IP) || ipnet2.Contains(ipnet1.IP)
+}
@@ -72,3 +72,29 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1   string
+		cidr2   string
+		overlap bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.1.0/24", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.1.0.0/16", false},
+		{"0.0.0.0/0", "192.168.0.0/24", true},
+		{"2001:db8::/32", "2001:db8:1::/48", true},
+		{"2001:db8::/32", "2001:db9::/32", false},
+		{"::/0", "0.0.0.0/0", false},
+		{"10.0.0.0/1234", "10.0.0.0/16", false},
+		{"", "", false},
+	} {
+		overlap := CIDRBlocksOverlap(ts.cidr1, ts.cidr2)
+		if ts.overlap != overlap {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.overlap)
+		}
+	}
+}
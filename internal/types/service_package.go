@@ -5,6 +5,7 @@ package types
 
 import (
 	"context"
+	"iter"
 	"slices"
 	"unique"
 
@@ -40,6 +41,11 @@ func ResourceRegionDisabled() ServicePackageResourceRegion {
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	MaxTags             int    // Overrides the service's maximum number of tags per resource.
+	MaxKeyLength        int    // Overrides the service's maximum tag key length.
+	MaxValueLength      int    // Overrides the service's maximum tag value length.
+	// Whether the service restricts tag keys and values to Unicode letters, digits, white space and _ . : / = + - @.
+	RestrictedCharacters bool
 }
 
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
@@ -51,6 +57,23 @@ type ServicePackageEphemeralResource struct {
 	Region   unique.Handle[ServicePackageResourceRegion]
 }
 
+// ServicePackageListResource represents a list resource implemented by a service package.
+// A list resource enumerates the existing resources of a managed resource type,
+// for example to discover resources that are not yet managed by Terraform.
+type ServicePackageListResource struct {
+	List     func(context.Context, any) iter.Seq2[ListResult, error]
+	TypeName string // The managed resource type name.
+	Name     string
+	Region   unique.Handle[ServicePackageResourceRegion]
+}
+
+// ListResult represents an existing resource found by a list resource.
+type ListResult struct {
+	ID          string            // The resource's import ID.
+	DisplayName string            // A human-readable name for the resource, e.g. its Name tag.
+	Identity    map[string]string // Resource identity attribute name -> value.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
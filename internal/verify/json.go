@@ -12,7 +12,6 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -41,7 +40,7 @@ func PolicyStringsEquivalent(s1, s2 string) bool {
 		return true
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
+	equivalent, err := policiesAreEquivalent(s1, s2)
 	if err != nil {
 		return false
 	}
@@ -147,7 +146,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := policiesAreEquivalent(old, new)
 
 	if err != nil {
 		// Plugin SDK V2 based resources can set malformed policy content in state
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// policiesAreEquivalent returns whether two IAM policy documents normalize to
+// the same canonical form.
+// Valid JSON documents which cannot be normalized (e.g. because of an element
+// with an unsupported data type) are equivalent only if they are equal JSON.
+func policiesAreEquivalent(policy1, policy2 string) (bool, error) {
+	normalized1, err1 := NormalizePolicyString(policy1)
+	if err1 != nil && !json.Valid([]byte(trimPolicyString(policy1))) {
+		return false, fmt.Errorf("parsing policy 1: %w", err1)
+	}
+
+	normalized2, err2 := NormalizePolicyString(policy2)
+	if err2 != nil && !json.Valid([]byte(trimPolicyString(policy2))) {
+		return false, fmt.Errorf("parsing policy 2: %w", err2)
+	}
+
+	if err1 != nil || err2 != nil {
+		return JSONStringsEqual(trimPolicyString(policy1), trimPolicyString(policy2)), nil
+	}
+
+	return normalized1 == normalized2, nil
+}
+
+// trimPolicyString removes the single element list which may wrap
+// an assume role policy document.
+func trimPolicyString(policy string) string {
+	policy = strings.TrimSpace(policy)
+
+	if strings.HasPrefix(policy, "[") && strings.HasSuffix(policy, "]") {
+		policy = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(policy, "["), "]"))
+	}
+	if policy == "" {
+		policy = "{}"
+	}
+
+	return policy
+}
+
+// canonicalPolicyDoc and canonicalPolicyStatement fix the order in which
+// policy elements are serialized. Version is kept first as required by IAM's
+// legacy policy parser.
+type canonicalPolicyDoc struct {
+	Version    string                      `json:",omitempty"`
+	Id         string                      `json:",omitempty"`
+	Statements []*canonicalPolicyStatement `json:"Statement,omitempty"`
+}
+
+type canonicalPolicyStatement struct {
+	Sid           string                    `json:",omitempty"`
+	Effect        string                    `json:",omitempty"`
+	Principals    any                       `json:"Principal,omitempty"`
+	NotPrincipals any                       `json:"NotPrincipal,omitempty"`
+	Actions       any                       `json:"Action,omitempty"`
+	NotActions    any                       `json:"NotAction,omitempty"`
+	Resources     any                       `json:"Resource,omitempty"`
+	NotResources  any                       `json:"NotResource,omitempty"`
+	Conditions    map[string]map[string]any `json:"Condition,omitempty"`
+}
+
+// NormalizePolicyString returns the canonical form of an IAM policy document.
+// PolicyStringsEquivalent compares the canonical forms, so equivalent policies
+// normalize identically: statement and value ordering and duplicates are ignored,
+// single element arrays are equivalent to scalar values, Effect is case-insensitive,
+// scalar condition values are compared as strings and an account's root user ARN
+// principal is equivalent to its account ID.
+func NormalizePolicyString(policy string) (string, error) {
+	policy = trimPolicyString(policy)
+
+	var raw struct {
+		Version    string
+		Id         string
+		Statements any `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return "", fmt.Errorf("parsing IAM policy: %w", err)
+	}
+
+	var rawStatements []any
+	switch v := raw.Statements.(type) {
+	case nil:
+	case []any:
+		rawStatements = v
+	case map[string]any:
+		rawStatements = []any{v}
+	default:
+		return "", fmt.Errorf("parsing IAM policy: unsupported data type %T for Statement", v)
+	}
+
+	doc := canonicalPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	type keyedStatement struct {
+		key       []byte
+		statement *canonicalPolicyStatement
+	}
+	var statements []keyedStatement
+
+	for i, v := range rawStatements {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("parsing IAM policy: Statement[%d]: unsupported data type %T", i, v)
+		}
+
+		statement, err := canonicalizePolicyStatement(m)
+		if err != nil {
+			return "", fmt.Errorf("parsing IAM policy: Statement[%d]: %w", i, err)
+		}
+
+		key, err := json.Marshal(statement)
+		if err != nil {
+			return "", err
+		}
+
+		statements = append(statements, keyedStatement{key: key, statement: statement})
+	}
+
+	slices.SortStableFunc(statements, func(a, b keyedStatement) int {
+		return bytes.Compare(a.key, b.key)
+	})
+	statements = slices.CompactFunc(statements, func(a, b keyedStatement) bool {
+		return bytes.Equal(a.key, b.key)
+	})
+	for _, v := range statements {
+		doc.Statements = append(doc.Statements, v.statement)
+	}
+
+	output, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func canonicalizePolicyStatement(m map[string]any) (*canonicalPolicyStatement, error) {
+	var statement canonicalPolicyStatement
+	var err error
+
+	if v, ok := m["Sid"]; ok {
+		if statement.Sid, ok = v.(string); !ok {
+			return nil, fmt.Errorf("unsupported data type %T for Sid", v)
+		}
+	}
+
+	if v, ok := m["Effect"]; ok {
+		effect, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("unsupported data type %T for Effect", v)
+		}
+		switch {
+		case strings.EqualFold(effect, "Allow"):
+			effect = "Allow"
+		case strings.EqualFold(effect, "Deny"):
+			effect = "Deny"
+		}
+		statement.Effect = effect
+	}
+
+	if statement.Principals, err = canonicalPolicyPrincipals(m["Principal"]); err != nil {
+		return nil, fmt.Errorf("Principal: %w", err)
+	}
+	if statement.NotPrincipals, err = canonicalPolicyPrincipals(m["NotPrincipal"]); err != nil {
+		return nil, fmt.Errorf("NotPrincipal: %w", err)
+	}
+	if statement.Actions, err = canonicalPolicyStringSet(m["Action"]); err != nil {
+		return nil, fmt.Errorf("Action: %w", err)
+	}
+	if statement.NotActions, err = canonicalPolicyStringSet(m["NotAction"]); err != nil {
+		return nil, fmt.Errorf("NotAction: %w", err)
+	}
+	if statement.Resources, err = canonicalPolicyStringSet(m["Resource"]); err != nil {
+		return nil, fmt.Errorf("Resource: %w", err)
+	}
+	if statement.NotResources, err = canonicalPolicyStringSet(m["NotResource"]); err != nil {
+		return nil, fmt.Errorf("NotResource: %w", err)
+	}
+
+	if v, ok := m["Condition"]; ok && v != nil {
+		conditions, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("Condition: unsupported data type %T", v)
+		}
+		for operator, v := range conditions {
+			block, ok := v.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("Condition: %s: unsupported data type %T", operator, v)
+			}
+			for key, v := range block {
+				values, err := canonicalPolicyStringSet(v)
+				if err != nil {
+					return nil, fmt.Errorf("Condition: %s: %s: %w", operator, key, err)
+				}
+				if values == nil {
+					// An empty set of condition values is preserved as such.
+					values = []string{}
+				}
+				if statement.Conditions == nil {
+					statement.Conditions = make(map[string]map[string]any)
+				}
+				if statement.Conditions[operator] == nil {
+					statement.Conditions[operator] = make(map[string]any)
+				}
+				statement.Conditions[operator][key] = values
+			}
+		}
+	}
+
+	return &statement, nil
+}
+
+// canonicalPolicyPrincipals returns the canonical form of a Principal or
+// NotPrincipal element. A scalar principal (e.g. "*") is kept as is.
+func canonicalPolicyPrincipals(v any) (any, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return canonicalPolicyPrincipal(v), nil
+	case map[string]any:
+		principals := make(map[string]any)
+		for typ, identifiers := range v {
+			values, err := policyStrings(identifiers)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", typ, err)
+			}
+			for i, v := range values {
+				values[i] = canonicalPolicyPrincipal(v)
+			}
+			if v := canonicalPolicyValues(values); v != nil {
+				principals[typ] = v
+			}
+		}
+		if len(principals) == 0 {
+			return nil, nil
+		}
+		return principals, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+// canonicalPolicyPrincipal returns the account ID for an account's root
+// user ARN principal (e.g. "arn:aws:iam::123456789012:root"). IAM accepts
+// both forms and treats them the same. Any other principal is kept as is.
+func canonicalPolicyPrincipal(principal string) string {
+	if v, err := arn.Parse(principal); err == nil && v.Service == "iam" && v.Resource == "root" && v.AccountID != "" {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+// canonicalPolicyStringSet returns the sorted, unique string values of a policy
+// element, a single string if there is exactly one value or nil if there are none.
+func canonicalPolicyStringSet(v any) (any, error) {
+	values, err := policyStrings(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return canonicalPolicyValues(values), nil
+}
+
+func canonicalPolicyValues(values []string) any {
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
+
+// policyStrings returns the string values of a policy element, which may
+// be a scalar value or an array of scalar values.
+func policyStrings(v any) ([]string, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case nil:
+	case []any:
+		for _, v := range v {
+			s, err := policyScalarString(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+	default:
+		s, err := policyScalarString(v)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, s)
+	}
+
+	return values, nil
+}
+
+func policyScalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", errors.New("unsupported null value")
+	default:
+		return "", fmt.Errorf("unsupported data type %T", v)
+	}
+}
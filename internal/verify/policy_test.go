@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+// TestNormalizePolicyString verifies that two policies normalize to the
+// same document exactly when PolicyStringsEquivalent considers them equivalent.
+func TestNormalizePolicyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1, policy2 string
+		equivalent       bool
+		unsupported      bool
+	}{
+		"statement order": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"single element array": {
+			policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"account ID and root ARN principal": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111122223333:root"},"Action":"sts:AssumeRole"}]}`,
+			equivalent: true,
+		},
+		"account ID and root ARN principals": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["444455556666","arn:aws:iam::111122223333:root"]},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["111122223333","arn:aws:iam::444455556666:root"]},"Action":"sts:AssumeRole"}]}`,
+			equivalent: true,
+		},
+		"different account principal": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::444455556666:root"},"Action":"sts:AssumeRole"}]}`,
+			equivalent: false,
+		},
+		"role principal": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111122223333:role/example"},"Action":"sts:AssumeRole"}]}`,
+			equivalent: false,
+		},
+		"duplicate condition values": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":["a","a","b"]}}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":["b","a","b"]}}}]}`,
+			equivalent: true,
+		},
+		"duplicate statements": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		"scalar condition value": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["false"]}}}]}`,
+			equivalent: true,
+		},
+		"different actions": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:DeleteObject"],"Resource":"*"}]}`,
+			equivalent: false,
+		},
+		"unsupported condition value": {
+			policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":{"a":"b"}}}}]}`,
+			policy2:     `{"Statement":[{"Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":{"a":"b"}}},"Action":"s3:GetObject","Effect":"Allow"}],"Version":"2012-10-17"}`,
+			equivalent:  true,
+			unsupported: true,
+		},
+		"different sid": {
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := PolicyStringsEquivalent(testCase.policy1, testCase.policy2), testCase.equivalent; got != want {
+				t.Fatalf("PolicyStringsEquivalent = %t, want %t", got, want)
+			}
+
+			if testCase.unsupported {
+				return
+			}
+
+			normalized1, err := NormalizePolicyString(testCase.policy1)
+			if err != nil {
+				t.Fatalf("normalizing policy 1: %s", err)
+			}
+			normalized2, err := NormalizePolicyString(testCase.policy2)
+			if err != nil {
+				t.Fatalf("normalizing policy 2: %s", err)
+			}
+
+			if got, want := normalized1 == normalized2, testCase.equivalent; got != want {
+				t.Errorf("normalized policies equal = %t, want %t\npolicy 1: %s\npolicy 2: %s", got, want, normalized1, normalized2)
+			}
+
+			// Normalizing a normalized policy must not change it.
+			for _, v := range []struct{ policy, normalized string }{
+				{testCase.policy1, normalized1},
+				{testCase.policy2, normalized2},
+			} {
+				if renormalized, err := NormalizePolicyString(v.normalized); err != nil {
+					t.Errorf("normalizing %s: %s", v.normalized, err)
+				} else if renormalized != v.normalized {
+					t.Errorf("normalization is not idempotent: %s != %s", renormalized, v.normalized)
+				}
+			}
+		})
+	}
+}
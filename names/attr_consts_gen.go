@@ -19,6 +19,7 @@ const (
 	AttrApplicationID              = "application_id"
 	AttrApplyImmediately           = "apply_immediately"
 	AttrAssociationID              = "association_id"
+	AttrAssumeRoleARN              = "assume_role_arn"
 	AttrAttributes                 = "attributes"
 	AttrAutoMinorVersionUpgrade    = "auto_minor_version_upgrade"
 	AttrAvailabilityZone           = "availability_zone"
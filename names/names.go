@@ -165,6 +165,17 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// PartitionForID returns the partition with the given ID.
+func PartitionForID(id string) (endpoints.Partition, bool) {
+	for _, partition := range endpoints.DefaultPartitions() {
+		if partition.ID() == id {
+			return partition, true
+		}
+	}
+
+	return endpoints.Partition{}, false
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
@@ -295,5 +306,6 @@ func HumanFriendly(service string) (string, error) {
 }
 
 const (
-	TopLevelRegionAttributeDescription = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
+	TopLevelAssumeRoleARNAttributeDescription = `ARN of an IAM Role to assume to manage this resource, for example to manage resources in a different AWS account. Defaults to the credentials set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
+	TopLevelRegionAttributeDescription        = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
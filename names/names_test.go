@@ -60,6 +60,51 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestPartitionForID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		input      string
+		expectedOK bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name:       "GovCloud",
+			input:      endpoints.AwsUsGovPartitionID,
+			expectedOK: true,
+		},
+		{
+			name:       "standard",
+			input:      endpoints.AwsPartitionID,
+			expectedOK: true,
+		},
+		{
+			name:  "unknown",
+			input: "aws-custom",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			partition, ok := PartitionForID(testCase.input)
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+			if ok {
+				if got, want := partition.ID(), testCase.input; got != want {
+					t.Errorf("got: %s, expected: %s", got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 